@@ -4,6 +4,7 @@ go 1.24.2
 
 require (
 	github.com/pb33f/libopenapi v0.21.12
+	github.com/speakeasy-api/jsonpath v0.6.2
 	github.com/stretchr/testify v1.10.0
 	github.com/urfave/cli/v3 v3.3.3
 	gopkg.in/yaml.v3 v3.0.1
@@ -15,6 +16,5 @@ require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/mailru/easyjson v0.7.7 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/speakeasy-api/jsonpath v0.6.2 // indirect
 	github.com/wk8/go-ordered-map/v2 v2.1.9-0.20240815153524-6ea36470d1bd // indirect
 )
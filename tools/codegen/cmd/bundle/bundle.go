@@ -0,0 +1,108 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/loader"
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	flagOpenAPIFile  = "openapi-file"
+	flagOutputFile   = "output-file"
+	flagFormat       = "format"
+	flagDereference  = "dereference"
+	flagDropExcluded = "drop-excluded"
+)
+
+// excludeTag is the tag of the operations left out of the generated code.
+const excludeTag = "excludeme"
+
+func Command() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:   "bundle",
+		Usage:  "resolve a multi-file OpenAPI spec into a single document",
+		Action: action,
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:     flagOpenAPIFile,
+				Usage:    "OpenAPI file to process",
+				Required: true,
+				Sources:  cli.EnvVars("OPENAPI_FILE"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:    flagOutputFile,
+				Usage:   "File to write the bundled document to. Defaults to stdout",
+				Sources: cli.EnvVars("OUTPUT_FILE"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  flagFormat,
+				Usage: "Output format. Supported: yaml, json. Defaults to json for .json output files and yaml otherwise",
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:  flagDereference,
+				Usage: "Replace every $ref, including references within the document, with the value it points to",
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:  flagDropExcluded,
+				Usage: "Drop the operations tagged " + excludeTag,
+			},
+		}, loader.Flags()...),
+	}
+}
+
+func outputFormat(c *cli.Command) string {
+	if f := c.String(flagFormat); f != "" {
+		return f
+	}
+
+	if filepath.Ext(c.String(flagOutputFile)) == ".json" {
+		return "json"
+	}
+
+	return "yaml"
+}
+
+func action(_ context.Context, c *cli.Command) error {
+	options := spec.BundleOptions{
+		Dereference: c.Bool(flagDereference),
+		ExcludeTag:  "",
+	}
+	if c.Bool(flagDropExcluded) {
+		options.ExcludeTag = excludeTag
+	}
+
+	doc, err := spec.BundleWithConfig(
+		c.String(flagOpenAPIFile), loader.Config(c), options,
+	)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("failed to bundle OpenAPI file: %v", err), 1)
+	}
+
+	w := io.Writer(os.Stdout)
+
+	if path := c.String(flagOutputFile); path != "" {
+		f, err := os.OpenFile(
+			path,
+			os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
+			0o644, //nolint:mnd
+		)
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("failed to open output file: %v", err), 1)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := spec.Encode(w, doc, outputFormat(c)); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	return nil
+}
@@ -3,11 +3,22 @@ package gen
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/loader"
 	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/csharp"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/dart"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/jsonschema"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/kotlin"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/msw"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/python"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/rust"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/swift"
 	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
-	"github.com/pb33f/libopenapi"
 	"github.com/urfave/cli/v3"
 )
 
@@ -15,6 +26,15 @@ const (
 	flagOpenAPIFile = "openapi-file"
 	flagOutputFile  = "output-file"
 	flagPlugin      = "plugin"
+	flagValidators  = "validators"
+	flagSchemaID    = "schema-id"
+	flagSharedTypes = "shared-types-file"
+	flagNamespace   = "namespace"
+	flagCollisions  = "namespace-collisions"
+	flagRename      = "rename-collisions"
+	flagPrune       = "prune"
+	flagKeepType    = "keep-type"
+	flagVerbose     = "verbose"
 )
 
 func Command() *cli.Command {
@@ -22,26 +42,77 @@ func Command() *cli.Command {
 		Name:   "gen",
 		Usage:  "generate code",
 		Action: action,
-		Flags: []cli.Flag{
-			&cli.StringFlag{ //nolint:exhaustruct
-				Name:     flagOpenAPIFile,
-				Usage:    "OpenAPI file to process",
+		Flags: append([]cli.Flag{
+			&cli.StringSliceFlag{ //nolint:exhaustruct
+				Name: flagOpenAPIFile,
+				Usage: "OpenAPI file to process. Repeat it, along with --" + flagOutputFile +
+					", to generate several services sharing their common types",
 				Required: true,
 				Sources:  cli.EnvVars("OPENAPI_FILE"),
 			},
-			&cli.StringFlag{ //nolint:exhaustruct
+			&cli.StringSliceFlag{ //nolint:exhaustruct
 				Name:     flagOutputFile,
-				Usage:    "Output file to write to",
+				Usage:    "Output file to write to, one per OpenAPI file",
 				Required: true,
 				Sources:  cli.EnvVars("OUTPUT_FILE"),
 			},
 			&cli.StringFlag{ //nolint:exhaustruct
 				Name:     flagPlugin,
-				Usage:    "Plugin to use. Supported: typescript",
+				Usage:    "Plugin to use. Supported: typescript, zod, python, dart, swift, kotlin, rust, csharp, jsonschema, msw",
 				Required: true,
 				Sources:  cli.EnvVars("PLUGIN"),
 			},
-		},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:    flagValidators,
+				Usage:   "Generate dependency-free validate functions. Supported by: typescript, zod",
+				Sources: cli.EnvVars("VALIDATORS"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name: flagSchemaID,
+				Usage: "Base $id of the generated schemas. Defaults to " +
+					"https://nhost.io/schemas/<openapi file name>. Supported by: jsonschema",
+				Sources: cli.EnvVars("SCHEMA_ID"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name: flagSharedTypes,
+				Usage: "File to write the types shared by several OpenAPI files to. " +
+					"Required with several OpenAPI files. Supported by: typescript, zod",
+				Sources: cli.EnvVars("SHARED_TYPES_FILE"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name: flagNamespace,
+				Usage: "Namespace (C#) or package (Kotlin) of the generated code, e.g. Nhost.Storage. " +
+					"Supported by: csharp, kotlin",
+				Sources: cli.EnvVars("NAMESPACE"),
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name: flagCollisions,
+				Usage: "Prefix types declared differently by several OpenAPI files with the name " +
+					"of their file instead of only reporting them",
+				Sources: cli.EnvVars("NAMESPACE_COLLISIONS"),
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name: flagRename,
+				Usage: "Rename types derived from schemas with different structures that end up " +
+					"with the same name, e.g. to UserSettings2, instead of failing",
+				Sources: cli.EnvVars("RENAME_COLLISIONS"),
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:    flagPrune,
+				Usage:   "Remove the types that no generated method uses",
+				Sources: cli.EnvVars("PRUNE"),
+			},
+			&cli.StringSliceFlag{ //nolint:exhaustruct
+				Name:    flagKeepType,
+				Usage:   "Name of a type to keep when pruning even if no method uses it. Can be repeated",
+				Sources: cli.EnvVars("KEEP_TYPES"),
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:    flagVerbose,
+				Usage:   "Print the types removed when pruning",
+				Sources: cli.EnvVars("VERBOSE"),
+			},
+		}, loader.Flags()...),
 	}
 }
 
@@ -52,37 +123,80 @@ func action(_ context.Context, c *cli.Command) error {
 
 	switch c.String(flagPlugin) {
 	case "typescript":
-		p = &typescript.Typescript{}
+		p = &typescript.Typescript{Zod: false, Validators: c.Bool(flagValidators)}
+	case "zod":
+		p = &typescript.Typescript{Zod: true, Validators: c.Bool(flagValidators)}
+	case "python":
+		p = &python.Python{}
+	case "dart":
+		p = &dart.Dart{}
+	case "swift":
+		p = &swift.Swift{}
+	case "kotlin":
+		p = &kotlin.Kotlin{Package: c.String(flagNamespace)}
+	case "rust":
+		p = &rust.Rust{}
+	case "csharp":
+		p = &csharp.CSharp{Namespace: c.String(flagNamespace)}
+	case "jsonschema":
+		p = &jsonschema.JSONSchema{ID: schemaID(c)}
+	case "msw":
+		p = &msw.MSW{} //nolint:exhaustruct
 	default:
 		return cli.Exit("unsupported plugin: %s"+c.String(flagPlugin), 1)
 	}
 
-	b, err := os.ReadFile(c.String(flagOpenAPIFile))
+	openapiFiles := c.StringSlice(flagOpenAPIFile)
+	outputFiles := c.StringSlice(flagOutputFile)
+
+	if len(openapiFiles) != len(outputFiles) {
+		return cli.Exit(fmt.Sprintf(
+			"got %d OpenAPI files but %d output files", len(openapiFiles), len(outputFiles),
+		), 1)
+	}
+
+	if len(openapiFiles) > 1 {
+		return generateShared(c, p, openapiFiles, outputFiles)
+	}
+
+	docModel, err := loader.Load(c, openapiFiles[0])
 	if err != nil {
-		return cli.Exit(fmt.Sprintf("failed to read OpenAPI file: %v", err), 1)
+		return cli.Exit(err.Error(), 1)
 	}
 
-	document, err := libopenapi.NewDocument(b)
+	ir, err := processor.NewInterMediateRepresentationWithOptions(docModel, p, irOptions(c))
 	if err != nil {
-		return cli.Exit(fmt.Sprintf("failed to parse OpenAPI document: %v", err), 1)
+		return cli.Exit(fmt.Sprintf("failed to create intermediate representation: %v", err), 1)
 	}
 
-	docModel, errors := document.BuildV3Model()
-	if len(errors) > 0 {
-		for i := range errors {
-			fmt.Printf("error: %e\n", errors[i]) //nolint:forbidigo
-		}
+	if err := render(outputFiles[0], ir); err != nil {
+		return err
+	}
 
-		return cli.Exit("failed to build OpenAPI model", 1)
+	printDeprecations(ir.Deprecations())
+
+	if c.Bool(flagVerbose) {
+		printPruned(ir.Pruned)
 	}
 
-	ir, err := processor.NewInterMediateRepresentation(docModel, p)
-	if err != nil {
-		return cli.Exit(fmt.Sprintf("failed to create intermediate representation: %v", err), 1)
+	return nil
+}
+
+func irOptions(c *cli.Command) processor.Options {
+	return processor.Options{
+		RenameCollisions: c.Bool(flagRename),
+		Prune:            c.Bool(flagPrune),
+		Keep:             c.StringSlice(flagKeepType),
 	}
+}
+
+type renderer interface {
+	Render(out io.Writer) error
+}
 
+func render(path string, r renderer) error {
 	f, err := os.OpenFile(
-		c.String(flagOutputFile),
+		path,
 		os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
 		0o644, //nolint:mnd
 	)
@@ -91,11 +205,51 @@ func action(_ context.Context, c *cli.Command) error {
 	}
 	defer f.Close()
 
-	if err := ir.Render(f); err != nil {
+	if err := r.Render(f); err != nil {
 		return cli.Exit(fmt.Sprintf("failed to write output: %v", err), 1)
 	}
 
-	fmt.Printf("Code generated successfully to %s\n", c.String(flagOutputFile)) //nolint:forbidigo
+	fmt.Printf("Code generated successfully to %s\n", path) //nolint:forbidigo
 
 	return nil
 }
+
+// schemaID returns the base $id of the JSON schemas, derived from the name of the
+// OpenAPI file unless set explicitly.
+func schemaID(c *cli.Command) string {
+	if id := c.String(flagSchemaID); id != "" {
+		return id
+	}
+
+	name := filepath.Base(c.StringSlice(flagOpenAPIFile)[0])
+
+	return "https://nhost.io/schemas/" + strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+func printDeprecations(deprecations []processor.Deprecation) {
+	if len(deprecations) == 0 {
+		return
+	}
+
+	fmt.Printf("Generated %d deprecated elements:\n", len(deprecations)) //nolint:forbidigo
+
+	for _, d := range deprecations {
+		if d.Message != "" {
+			fmt.Printf("  - %s %s: %s\n", d.Kind, d.Name, d.Message) //nolint:forbidigo
+		} else {
+			fmt.Printf("  - %s %s\n", d.Kind, d.Name) //nolint:forbidigo
+		}
+	}
+}
+
+func printPruned(pruned []processor.Type) {
+	if len(pruned) == 0 {
+		return
+	}
+
+	fmt.Printf("Pruned %d unused types:\n", len(pruned)) //nolint:forbidigo
+
+	for _, t := range pruned {
+		fmt.Printf("  - %s\n", t.Name()) //nolint:forbidigo
+	}
+}
@@ -0,0 +1,117 @@
+package gen
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/loader"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/urfave/cli/v3"
+)
+
+// serviceName returns the name of the service described by the OpenAPI file,
+// e.g. auth for api/auth.yaml.
+func serviceName(openapiFile string) string {
+	name := filepath.Base(openapiFile)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// importPath returns how the file at from imports the module at path, e.g.
+// ../types for src/auth/client.ts importing src/types.ts.
+func importPath(from, path string) (string, error) {
+	rel, err := filepath.Rel(filepath.Dir(from), path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path of shared types file: %w", err)
+	}
+
+	rel = filepath.ToSlash(strings.TrimSuffix(rel, filepath.Ext(rel)))
+	if !strings.HasPrefix(rel, ".") {
+		rel = "./" + rel
+	}
+
+	return rel, nil
+}
+
+// generateShared generates several services at once, declaring their common types
+// in the shared types file.
+func generateShared(
+	c *cli.Command, p processor.Plugin, openapiFiles, outputFiles []string,
+) error {
+	sharedFile := c.String(flagSharedTypes)
+	if sharedFile == "" {
+		return cli.Exit("--"+flagSharedTypes+" is required with several OpenAPI files", 1)
+	}
+
+	if c.Bool(flagValidators) {
+		return cli.Exit("--"+flagValidators+" is not supported with several OpenAPI files", 1)
+	}
+
+	// fail before writing any file
+	if !processor.SupportsSharedTypes(p) {
+		return cli.Exit(
+			"plugin "+c.String(flagPlugin)+" doesn't support several OpenAPI files", 1,
+		)
+	}
+
+	documents := make([]processor.ServiceDocument, 0, len(openapiFiles))
+
+	for _, path := range openapiFiles {
+		docModel, err := loader.Load(c, path)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		documents = append(documents, processor.ServiceDocument{
+			Name:     serviceName(path),
+			Document: docModel,
+		})
+	}
+
+	shared, err := processor.NewSharedRepresentation(
+		documents, p, processor.SharedOptions{Options: irOptions(c), Namespace: c.Bool(flagCollisions)},
+	)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("failed to create intermediate representation: %v", err), 1)
+	}
+
+	if err := render(sharedFile, shared); err != nil {
+		return err
+	}
+
+	for i, service := range shared.Services {
+		if service.SharedModule, err = importPath(outputFiles[i], sharedFile); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		if err := render(outputFiles[i], service); err != nil {
+			return err
+		}
+
+		printDeprecations(service.Deprecations())
+
+		if c.Bool(flagVerbose) {
+			printPruned(service.Pruned)
+		}
+	}
+
+	printCollisions(shared.Collisions, c.Bool(flagCollisions))
+
+	return nil
+}
+
+func printCollisions(collisions []processor.Collision, namespaced bool) {
+	if len(collisions) == 0 {
+		return
+	}
+
+	if namespaced {
+		fmt.Printf("Namespaced %d types declared differently by several services:\n", len(collisions)) //nolint:forbidigo
+	} else {
+		fmt.Printf("Found %d types declared differently by several services:\n", len(collisions)) //nolint:forbidigo
+	}
+
+	for _, collision := range collisions {
+		fmt.Printf("  - %s: %s\n", collision.Name, strings.Join(collision.Services, ", ")) //nolint:forbidigo
+	}
+}
@@ -0,0 +1,104 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/lint"
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	flagOpenAPIFile = "openapi-file"
+	flagConfigFile  = "config-file"
+	flagFormat      = "format"
+	flagOutputFile  = "output-file"
+)
+
+func Command() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:   "lint",
+		Usage:  "check an OpenAPI file against the conventions of the project",
+		Action: action,
+		Flags: []cli.Flag{
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:     flagOpenAPIFile,
+				Usage:    "OpenAPI file to process",
+				Required: true,
+				Sources:  cli.EnvVars("OPENAPI_FILE"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:    flagConfigFile,
+				Usage:   "YAML or JSON file overriding the severity (error, warning, info or off) of the rules",
+				Sources: cli.EnvVars("LINT_CONFIG_FILE"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  flagFormat,
+				Usage: "Output format. Supported: text, sarif",
+				Value: "text",
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  flagOutputFile,
+				Usage: "File to write the report to. Defaults to stdout",
+			},
+		},
+	}
+}
+
+func write(w io.Writer, c *cli.Command, linter *lint.Linter, diagnostics []lint.Diagnostic) error {
+	switch c.String(flagFormat) {
+	case "text":
+		return lint.WriteText(w, c.String(flagOpenAPIFile), diagnostics)
+	case "sarif":
+		return lint.WriteSARIF(w, c.String(flagOpenAPIFile), linter, diagnostics)
+	default:
+		return fmt.Errorf("unknown format: %s", c.String(flagFormat)) //nolint:err113
+	}
+}
+
+func action(_ context.Context, c *cli.Command) error {
+	var config *lint.Config
+	if path := c.String(flagConfigFile); path != "" {
+		var err error
+		if config, err = lint.LoadConfig(path); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+	}
+
+	linter, err := lint.New(lint.DefaultRules(), config)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("failed to create linter: %v", err), 1)
+	}
+
+	doc, err := spec.Load(c.String(flagOpenAPIFile))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	diagnostics := linter.Lint(&doc.Model)
+
+	w := io.Writer(os.Stdout)
+
+	if path := c.String(flagOutputFile); path != "" {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644) //nolint:mnd
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("failed to open output file: %v", err), 1)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := write(w, c, linter, diagnostics); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	if lint.HasErrors(diagnostics) {
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
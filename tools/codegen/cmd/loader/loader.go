@@ -0,0 +1,41 @@
+// Package loader declares the flags of the commands loading an OpenAPI file so
+// they all resolve references and apply overlays the same way.
+package loader
+
+import (
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+	"github.com/pb33f/libopenapi"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	flagBaseDir = "base-dir"
+	flagOverlay = "overlay"
+)
+
+// Flags returns the flags configuring how the OpenAPI file is loaded.
+func Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:    flagBaseDir,
+			Usage:   "Directory relative $refs to other files are resolved from. Defaults to the directory of the OpenAPI file",
+			Sources: cli.EnvVars("BASE_DIR"),
+		},
+		&cli.StringFlag{ //nolint:exhaustruct
+			Name:    flagOverlay,
+			Usage:   "OpenAPI Overlay file applied to the OpenAPI file before processing it",
+			Sources: cli.EnvVars("OVERLAY_FILE"),
+		},
+	}
+}
+
+// Config returns the configuration set by the flags.
+func Config(c *cli.Command) spec.Config {
+	return spec.Config{BaseDir: c.String(flagBaseDir), Overlay: c.String(flagOverlay)}
+}
+
+// Load loads the OpenAPI file at path with the configuration set by the flags.
+func Load(c *cli.Command, path string) (*libopenapi.DocumentModel[v3.Document], error) {
+	return spec.LoadWithConfig(path, Config(c))
+}
@@ -0,0 +1,141 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/loader"
+	"github.com/nhost/sdk-experiment/tools/codegen/mock"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	flagOpenAPIFile  = "openapi-file"
+	flagPort         = "port"
+	flagScenarioFile = "scenario-file"
+)
+
+const (
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+func Command() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:   "mock",
+		Usage:  "serve the operations of an OpenAPI file with example responses",
+		Action: action,
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:     flagOpenAPIFile,
+				Usage:    "OpenAPI file to serve",
+				Required: true,
+				Sources:  cli.EnvVars("OPENAPI_FILE"),
+			},
+			&cli.IntFlag{ //nolint:exhaustruct
+				Name:    flagPort,
+				Usage:   "Port to listen on",
+				Value:   8080, //nolint:mnd
+				Sources: cli.EnvVars("PORT"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:    flagScenarioFile,
+				Usage:   "YAML or JSON file overriding the responses per operationId",
+				Sources: cli.EnvVars("SCENARIO_FILE"),
+			},
+		}, loader.Flags()...),
+	}
+}
+
+func loadIR(c *cli.Command) (*processor.InterMediateRepresentation, error) {
+	docModel, err := loader.Load(c, c.String(flagOpenAPIFile))
+	if err != nil {
+		return nil, err
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(docModel, &processor.DocumentNames{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create intermediate representation: %w", err)
+	}
+
+	return ir, nil
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		fmt.Printf("%s %s -> %d\n", r.Method, r.URL.RequestURI(), rec.status) //nolint:forbidigo
+	})
+}
+
+func action(ctx context.Context, c *cli.Command) error {
+	ir, err := loadIR(c)
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	var scenario *mock.Scenario
+	if path := c.String(flagScenarioFile); path != "" {
+		if scenario, err = mock.LoadScenario(path); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+	}
+
+	handler, err := mock.New(ir, scenario)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("failed to create mock server: %v", err), 1)
+	}
+
+	server := &http.Server{ //nolint:exhaustruct
+		Addr:              ":" + strconv.Itoa(c.Int(flagPort)),
+		Handler:           logRequests(handler),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.ListenAndServe()
+	}()
+
+	fmt.Printf( //nolint:forbidigo
+		"Serving %d operations on http://localhost%s\n", len(ir.Methods), server.Addr,
+	)
+
+	select {
+	case err := <-errCh:
+		return cli.Exit(fmt.Sprintf("failed to serve: %v", err), 1)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil { //nolint:contextcheck
+		return cli.Exit(fmt.Sprintf("failed to shut down: %v", err), 1)
+	}
+
+	return nil
+}
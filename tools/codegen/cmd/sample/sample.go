@@ -0,0 +1,87 @@
+package sample
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/loader"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/sample"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	flagOpenAPIFile = "openapi-file"
+	flagType        = "type"
+	flagSeed        = "seed"
+	flagExamples    = "examples"
+)
+
+func Command() *cli.Command {
+	return &cli.Command{ //nolint:exhaustruct
+		Name:   "sample",
+		Usage:  "print a sample value of a type as JSON",
+		Action: action,
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:     flagOpenAPIFile,
+				Usage:    "OpenAPI file to process",
+				Required: true,
+				Sources:  cli.EnvVars("OPENAPI_FILE"),
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:     flagType,
+				Usage:    "Name of the type in the OpenAPI document, e.g. Session",
+				Required: true,
+			},
+			&cli.UintFlag{ //nolint:exhaustruct
+				Name:  flagSeed,
+				Usage: "Seed of the generator. The same seed always produces the same value",
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:  flagExamples,
+				Usage: "Use the examples of the schemas when present",
+			},
+		}, loader.Flags()...),
+	}
+}
+
+func action(_ context.Context, c *cli.Command) error {
+	doc, err := loader.Load(c, c.String(flagOpenAPIFile))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &processor.DocumentNames{})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("failed to create intermediate representation: %v", err), 1)
+	}
+
+	var typ processor.Type
+
+	for _, t := range ir.Types {
+		if t.Name() == c.String(flagType) {
+			typ = t
+			break
+		}
+	}
+
+	if typ == nil {
+		return cli.Exit("unknown type: "+c.String(flagType), 1)
+	}
+
+	g := sample.New(uint64(c.Uint(flagSeed)))
+	g.Examples = c.Bool(flagExamples)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(g.Value(typ)); err != nil {
+		return cli.Exit(fmt.Sprintf("failed to encode sample: %v", err), 1)
+	}
+
+	return nil
+}
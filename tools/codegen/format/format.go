@@ -1,12 +1,89 @@
 package format
 
 import (
+	"net/url"
+	"path"
+	"strconv"
 	"strings"
 	"unicode"
 )
 
+// schemaKeywords are the segments of JSON pointers that navigate into a schema
+// instead of naming something, e.g. properties in #/User/properties/address.
+var schemaKeywords = map[string]bool{ //nolint:gochecknoglobals
+	"properties":           true,
+	"items":                true,
+	"additionalProperties": true,
+	"allOf":                true,
+	"oneOf":                true,
+	"anyOf":                true,
+	"schema":               true,
+	"content":              true,
+}
+
+// pointerSegments returns the unescaped segments of the JSON pointer.
+func pointerSegments(pointer string) []string {
+	segments := make([]string, 0, 4) //nolint:mnd
+
+	for _, segment := range strings.Split(pointer, "/") {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
+
+// GetNameFromComponentRef returns the name of the type a $ref points to:
+//
+//   - #/components/schemas/User and ./common.yaml#/components/schemas/User -> User
+//   - ./schemas/user.yaml#/User -> User
+//   - ./schemas/user-settings.yaml -> UserSettings
+//   - #/components/schemas/User/properties/address -> UserAddress
+//
+// Refs into nested schemas are named like the IR names nested objects, appending
+// the names of the properties to the name of the parent.
 func GetNameFromComponentRef(ref string) string {
-	return strings.Split(ref, "/")[3]
+	file, pointer, _ := strings.Cut(ref, "#")
+	segments := pointerSegments(pointer)
+
+	if len(segments) == 0 {
+		// the ref points to a whole file, which is named after the file
+		words := Words(strings.TrimSuffix(path.Base(file), path.Ext(file)))
+		for i := range words {
+			words[i] = Title(words[i])
+		}
+
+		return strings.Join(words, "")
+	}
+
+	switch {
+	case len(segments) >= 3 && segments[0] == "components": //nolint:mnd
+		segments = segments[2:]
+	case len(segments) >= 2 && (segments[0] == "definitions" || segments[0] == "$defs"): //nolint:mnd
+		segments = segments[1:]
+	}
+
+	name := segments[0]
+
+	for i := 1; i < len(segments); i++ {
+		switch _, err := strconv.Atoi(segments[i]); {
+		case segments[i] == "properties" && i+1 < len(segments):
+			// the segment after properties is the name of a property, even if it's a keyword
+			i++
+			name += Title(segments[i])
+		case err == nil || schemaKeywords[segments[i]]:
+		default:
+			name += Title(segments[i])
+		}
+	}
+
+	return name
 }
 
 // Capitalize the first letter of a string.
@@ -41,3 +118,67 @@ func ToCamelCase(s string) string {
 
 	return strings.Join(parts, "")
 }
+
+// Words splits s into its words. Any character that is not a letter or a digit acts
+// as a separator and case changes start a new word (displayName, HTTPServer).
+func Words(s string) []string {
+	words := make([]string, 0, 4) //nolint:mnd
+	runes := []rune(s)
+	start := -1
+
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start != -1 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+
+			continue
+		}
+
+		if start != -1 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+
+		if start == -1 {
+			start = i
+		}
+	}
+
+	if start != -1 {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
+
+// ToSnakeCase converts s to snake_case (e.g. displayName -> display_name).
+func ToSnakeCase(s string) string {
+	words := Words(s)
+	for i := range words {
+		words[i] = strings.ToLower(words[i])
+	}
+
+	return strings.Join(words, "_")
+}
+
+// ToLowerCamelCase converts s to lowerCamelCase (e.g. bucket-id -> bucketId). The
+// case of the letters after the first one of each word is preserved.
+func ToLowerCamelCase(s string) string {
+	words := Words(s)
+	for i := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(words[i][:1]) + words[i][1:]
+		} else {
+			words[i] = Title(words[i])
+		}
+	}
+
+	return strings.Join(words, "")
+}
@@ -72,3 +72,152 @@ func TestToCamelCase(t *testing.T) {
 		})
 	}
 }
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		text string
+		want string
+	}{
+		{
+			text: "displayName",
+			want: "display_name",
+		},
+		{
+			text: "SignUpEmailPassword",
+			want: "sign_up_email_password",
+		},
+		{
+			text: "bucket-id",
+			want: "bucket_id",
+		},
+		{
+			text: "file[]",
+			want: "file",
+		},
+		{
+			text: "HTTPServer",
+			want: "http_server",
+		},
+		{
+			text: "getFileURL2",
+			want: "get_file_url2",
+		},
+		{
+			text: "",
+			want: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.text, func(t *testing.T) {
+			t.Parallel()
+
+			got := format.ToSnakeCase(tc.text)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestToLowerCamelCase(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		text string
+		want string
+	}{
+		{
+			text: "displayName",
+			want: "displayName",
+		},
+		{
+			text: "SignUpEmailPassword",
+			want: "signUpEmailPassword",
+		},
+		{
+			text: "bucket-id",
+			want: "bucketId",
+		},
+		{
+			text: "file[]",
+			want: "file",
+		},
+		{
+			text: "redirect_to",
+			want: "redirectTo",
+		},
+		{
+			text: "",
+			want: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.text, func(t *testing.T) {
+			t.Parallel()
+
+			got := format.ToLowerCamelCase(tc.text)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetNameFromComponentRef(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		ref  string
+		want string
+	}{
+		{
+			ref:  "#/components/schemas/User",
+			want: "User",
+		},
+		{
+			ref:  "#/components/parameters/Limit",
+			want: "Limit",
+		},
+		{
+			ref:  "./common.yaml#/components/schemas/ErrorResponse",
+			want: "ErrorResponse",
+		},
+		{
+			ref:  "./schemas/user.yaml#/User",
+			want: "User",
+		},
+		{
+			ref:  "./schemas/user-settings.yaml",
+			want: "UserSettings",
+		},
+		{
+			ref:  "https://example.com/schemas/page_info.json",
+			want: "PageInfo",
+		},
+		{
+			ref:  "#/components/schemas/User/properties/address",
+			want: "UserAddress",
+		},
+		{
+			ref:  "common.yaml#/definitions/Page/properties/items/items",
+			want: "PageItems",
+		},
+		{
+			ref:  "#/components/schemas/Pet/oneOf/0",
+			want: "Pet",
+		},
+		{
+			ref:  "#/components/schemas/a~1b",
+			want: "a/b",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ref, func(t *testing.T) {
+			t.Parallel()
+
+			got := format.GetNameFromComponentRef(tc.ref)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// shape returns a string identifying the structure of the schema: types, formats,
+// enums, required properties and the shape of nested schemas. Descriptions and
+// examples are ignored so schemas that only differ in documentation are equal.
+func shape(proxy *base.SchemaProxy) string {
+	if proxy == nil {
+		return ""
+	}
+
+	if proxy.IsReference() {
+		return "$ref:" + proxy.GetReference()
+	}
+
+	s := proxy.Schema()
+	if s == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "type:%s;format:%s;", strings.Join(s.Type, ","), s.Format)
+
+	if len(s.Enum) > 0 {
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = v.Value
+		}
+
+		fmt.Fprintf(&b, "enum:%s;", strings.Join(values, ","))
+	}
+
+	if len(s.Required) > 0 {
+		required := append([]string{}, s.Required...)
+		sort.Strings(required)
+		fmt.Fprintf(&b, "required:%s;", strings.Join(required, ","))
+	}
+
+	if s.Properties != nil {
+		props := make([]string, 0, s.Properties.Len())
+		for propPair := s.Properties.First(); propPair != nil; propPair = propPair.Next() {
+			props = append(props, propPair.Key()+"="+shape(propPair.Value()))
+		}
+
+		sort.Strings(props)
+		fmt.Fprintf(&b, "properties:{%s};", strings.Join(props, ","))
+	}
+
+	if s.Items != nil && s.Items.IsA() {
+		fmt.Fprintf(&b, "items:{%s};", shape(s.Items.A))
+	}
+
+	if s.AdditionalProperties != nil && s.AdditionalProperties.IsA() {
+		fmt.Fprintf(&b, "additionalProperties:{%s};", shape(s.AdditionalProperties.A))
+	}
+
+	for _, composition := range []struct {
+		name string
+		subs []*base.SchemaProxy
+	}{
+		{name: "allOf", subs: s.AllOf},
+		{name: "oneOf", subs: s.OneOf},
+		{name: "anyOf", subs: s.AnyOf},
+	} {
+		if len(composition.subs) > 0 {
+			shapes := make([]string, len(composition.subs))
+			for i, sub := range composition.subs {
+				shapes[i] = shape(sub)
+			}
+
+			fmt.Fprintf(&b, "%s:{%s};", composition.name, strings.Join(shapes, ","))
+		}
+	}
+
+	return b.String()
+}
+
+// walkSchema calls visit for the schema and every inline schema nested in it.
+// References are not followed.
+func walkSchema(proxy *base.SchemaProxy, visit func(*base.SchemaProxy)) {
+	if proxy == nil || proxy.IsReference() || proxy.Schema() == nil {
+		return
+	}
+
+	visit(proxy)
+
+	s := proxy.Schema()
+
+	if s.Properties != nil {
+		for propPair := s.Properties.First(); propPair != nil; propPair = propPair.Next() {
+			walkSchema(propPair.Value(), visit)
+		}
+	}
+
+	if s.Items != nil && s.Items.IsA() {
+		walkSchema(s.Items.A, visit)
+	}
+
+	if s.AdditionalProperties != nil && s.AdditionalProperties.IsA() {
+		walkSchema(s.AdditionalProperties.A, visit)
+	}
+
+	for _, subs := range [][]*base.SchemaProxy{s.AllOf, s.OneOf, s.AnyOf} {
+		for _, sub := range subs {
+			walkSchema(sub, visit)
+		}
+	}
+}
+
+func walkContent(content *orderedmap.Map[string, *v3.MediaType], visit func(*base.SchemaProxy)) {
+	for mediaPair := content.First(); mediaPair != nil; mediaPair = mediaPair.Next() {
+		walkSchema(mediaPair.Value().Schema, visit)
+	}
+}
+
+// walkOperationSchemas calls visit for the inline schemas of the parameters,
+// request bodies and responses of the operations.
+func walkOperationSchemas(doc *v3.Document, visit func(*base.SchemaProxy)) {
+	for _, o := range operations(doc) {
+		for _, param := range o.op.Parameters {
+			walkSchema(param.Schema, visit)
+			walkContent(param.Content, visit)
+		}
+
+		if o.op.RequestBody != nil {
+			walkContent(o.op.RequestBody.Content, visit)
+		}
+
+		if o.op.Responses == nil {
+			continue
+		}
+
+		for codePair := o.op.Responses.Codes.First(); codePair != nil; codePair = codePair.Next() {
+			walkContent(codePair.Value().Content, visit)
+		}
+
+		if o.op.Responses.Default != nil {
+			walkContent(o.op.Responses.Default.Content, visit)
+		}
+	}
+}
+
+func isObject(proxy *base.SchemaProxy) bool {
+	s := proxy.Schema()
+	return s.Properties != nil && s.Properties.Len() > 0
+}
+
+// InlineObject checks that objects defined inline aren't duplicated, either with
+// other inline objects or with component schemas. Duplicated objects produce
+// several types with the same structure in the generated code and should be
+// moved to the components.
+type InlineObject struct{}
+
+func (r *InlineObject) Name() string {
+	return "inline-object"
+}
+
+func (r *InlineObject) Description() string {
+	return "Objects defined inline don't duplicate other objects"
+}
+
+func (r *InlineObject) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+func (r *InlineObject) Check(doc *v3.Document, report Report) {
+	components := make(map[string]string)
+	inline := make(map[string][]*yaml.Node)
+	shapes := make([]string, 0)
+
+	collect := func(proxy *base.SchemaProxy) {
+		node := proxy.GoLow().GetValueNode()
+		if !isObject(proxy) || node == nil {
+			return
+		}
+
+		key := shape(proxy)
+		if _, ok := inline[key]; !ok {
+			shapes = append(shapes, key)
+		}
+
+		inline[key] = append(inline[key], node)
+	}
+
+	if doc.Components != nil {
+		for schemaPair := doc.Components.Schemas.First(); schemaPair != nil; schemaPair = schemaPair.Next() {
+			proxy := schemaPair.Value()
+			if proxy.IsReference() || proxy.Schema() == nil {
+				continue
+			}
+
+			if isObject(proxy) {
+				if _, ok := components[shape(proxy)]; !ok {
+					components[shape(proxy)] = schemaPair.Key()
+				}
+			}
+
+			walkSchema(proxy, func(nested *base.SchemaProxy) {
+				if nested != proxy {
+					collect(nested)
+				}
+			})
+		}
+	}
+
+	walkOperationSchemas(doc, collect)
+
+	for _, key := range shapes {
+		nodes := inline[key]
+		sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].Line < nodes[j].Line })
+
+		if name, ok := components[key]; ok {
+			for _, node := range nodes {
+				report(node, "inline object duplicates component schema "+name)
+			}
+
+			continue
+		}
+
+		for _, node := range nodes[1:] {
+			report(node, fmt.Sprintf(
+				"inline object duplicates the one at line %d, consider moving it to the components",
+				nodes[0].Line,
+			))
+		}
+	}
+}
+
+// UnusedComponent checks that every component is referenced somewhere in the
+// document.
+type UnusedComponent struct{}
+
+func (r *UnusedComponent) Name() string {
+	return "unused-component"
+}
+
+func (r *UnusedComponent) Description() string {
+	return "Components are referenced in the document"
+}
+
+func (r *UnusedComponent) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+// references adds the values of all the $ref keys under node to refs.
+func references(node *yaml.Node, refs map[string]bool) {
+	if node == nil {
+		return
+	}
+
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "$ref" && node.Content[i+1].Kind == yaml.ScalarNode {
+				refs[node.Content[i+1].Value] = true
+			}
+		}
+	}
+
+	for _, child := range node.Content {
+		references(child, refs)
+	}
+}
+
+// componentKeys returns the nodes of the names of the components in the map.
+func componentKeys[T any](m *orderedmap.Map[low.KeyReference[string], low.ValueReference[T]]) []*yaml.Node {
+	keys := make([]*yaml.Node, 0)
+
+	for pair := m.First(); pair != nil; pair = pair.Next() {
+		if pair.Key().KeyNode != nil {
+			keys = append(keys, pair.Key().KeyNode)
+		}
+	}
+
+	return keys
+}
+
+func (r *UnusedComponent) Check(doc *v3.Document, report Report) {
+	if doc.Components == nil || doc.GoLow() == nil || doc.GoLow().Index == nil {
+		return
+	}
+
+	refs := make(map[string]bool)
+	references(doc.GoLow().Index.GetRootNode(), refs)
+
+	components := doc.Components.GoLow()
+
+	for _, kind := range []struct {
+		name string
+		keys []*yaml.Node
+	}{
+		{name: "schemas", keys: componentKeys(components.Schemas.Value)},
+		{name: "responses", keys: componentKeys(components.Responses.Value)},
+		{name: "parameters", keys: componentKeys(components.Parameters.Value)},
+		{name: "requestBodies", keys: componentKeys(components.RequestBodies.Value)},
+		{name: "headers", keys: componentKeys(components.Headers.Value)},
+	} {
+		for _, key := range kind.keys {
+			if !refs["#/components/"+kind.name+"/"+key.Value] {
+				report(key, fmt.Sprintf("component %s/%s is never referenced", kind.name, key.Value))
+			}
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Package lint checks OpenAPI documents against the conventions the generated
+// SDKs rely on, e.g. camelCase operationIds or shared error responses.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrUnknownRule     = errors.New("unknown rule")
+	ErrUnknownSeverity = errors.New("unknown severity")
+)
+
+type Severity string
+
+const (
+	SeverityOff     Severity = "off"
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// Report records a problem found by a rule. node locates the problem in the
+// document and may be nil when it can't be located.
+type Report func(node *yaml.Node, message string)
+
+// Rule checks a single convention. Rules report every problem they find and
+// the linter assigns them the configured severity.
+type Rule interface {
+	// Name identifies the rule in configuration files and reports, e.g. operation-id
+	Name() string
+	// Description explains what the rule checks in a sentence
+	Description() string
+	// DefaultSeverity is used unless the configuration overrides it
+	DefaultSeverity() Severity
+	Check(doc *v3.Document, report Report)
+}
+
+// Diagnostic is a problem found in the document.
+type Diagnostic struct {
+	Rule     string
+	Severity Severity
+	Message  string
+	Line     int
+	Column   int
+}
+
+// Config overrides the severity of the rules. It is read from YAML or JSON files
+// like:
+//
+//	rules:
+//	  operation-description: off
+//	  unused-component: error
+type Config struct {
+	Rules map[string]Severity `json:"rules" yaml:"rules"`
+}
+
+// LoadConfig reads a configuration file.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lint configuration: %w", err)
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(b, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse lint configuration: %w", err)
+	}
+
+	return &config, nil
+}
+
+type Linter struct {
+	rules    []Rule
+	severity map[string]Severity
+}
+
+// New returns a linter running the rules with the severities of the configuration,
+// which may be nil to use the defaults.
+func New(rules []Rule, config *Config) (*Linter, error) {
+	severity := make(map[string]Severity, len(rules))
+	for _, rule := range rules {
+		severity[rule.Name()] = rule.DefaultSeverity()
+	}
+
+	if config != nil {
+		for name, s := range config.Rules {
+			if _, ok := severity[name]; !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownRule, name)
+			}
+
+			switch s {
+			case SeverityOff, SeverityInfo, SeverityWarning, SeverityError:
+			default:
+				return nil, fmt.Errorf("%w for rule %s: %s", ErrUnknownSeverity, name, s)
+			}
+
+			severity[name] = s
+		}
+	}
+
+	return &Linter{
+		rules:    rules,
+		severity: severity,
+	}, nil
+}
+
+// Rules returns the rules run by the linter.
+func (l *Linter) Rules() []Rule {
+	return l.rules
+}
+
+// Severity returns the configured severity of the rule.
+func (l *Linter) Severity(rule string) Severity {
+	return l.severity[rule]
+}
+
+// Lint runs the enabled rules and returns the problems found ordered by position.
+func (l *Linter) Lint(doc *v3.Document) []Diagnostic {
+	diagnostics := make([]Diagnostic, 0)
+
+	for _, rule := range l.rules {
+		severity := l.severity[rule.Name()]
+		if severity == SeverityOff {
+			continue
+		}
+
+		rule.Check(doc, func(node *yaml.Node, message string) {
+			d := Diagnostic{
+				Rule:     rule.Name(),
+				Severity: severity,
+				Message:  message,
+				Line:     0,
+				Column:   0,
+			}
+
+			if node != nil {
+				d.Line, d.Column = node.Line, node.Column
+			}
+
+			diagnostics = append(diagnostics, d)
+		})
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].Line != diagnostics[j].Line {
+			return diagnostics[i].Line < diagnostics[j].Line
+		}
+
+		return diagnostics[i].Column < diagnostics[j].Column
+	})
+
+	return diagnostics
+}
+
+// HasErrors returns true if any of the diagnostics is an error.
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,139 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/lint"
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func run(t *testing.T, config *lint.Config) (*lint.Linter, []lint.Diagnostic) {
+	t.Helper()
+
+	doc, err := spec.Load("testdata/lint.yaml")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	linter, err := lint.New(lint.DefaultRules(), config)
+	if err != nil {
+		t.Fatalf("failed to create linter: %v", err)
+	}
+
+	return linter, linter.Lint(&doc.Model)
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	_, diagnostics := run(t, nil)
+
+	var b bytes.Buffer
+	if err := lint.WriteText(&b, "lint.yaml", diagnostics); err != nil {
+		t.Fatalf("failed to write diagnostics: %v", err)
+	}
+
+	expected := `lint.yaml:9:11: warning: tag "unused" is not used by any operation (tags)
+lint.yaml:27:19: warning: inline object duplicates component schema User (inline-object)
+lint.yaml:38:17: warning: response 400 of listUsers doesn't reference ErrorResponse (error-response)
+lint.yaml:42:5: warning: CreateUser has no summary (operation-summary)
+lint.yaml:42:5: warning: CreateUser has no description (operation-description)
+lint.yaml:43:20: error: operationId "CreateUser" is not camelCase (operation-id)
+lint.yaml:46:11: warning: tag "admin" of CreateUser is not declared (tags)
+lint.yaml:51:15: warning: inline object duplicates component schema User (inline-object)
+lint.yaml:57:7: error: CreateUser has no successful response (success-response)
+lint.yaml:66:5: error: DELETE /users/{id} has no operationId (operation-id)
+lint.yaml:66:5: warning: DELETE /users/{id} is only tagged excludeme (tags)
+lint.yaml:70:11: warning: tag "excludeme" of DELETE /users/{id} is not declared (tags)
+lint.yaml:91:17: warning: response 409 of DELETE /users/{id} doesn't reference ErrorResponse (error-response)
+lint.yaml:101:5: warning: component schemas/User is never referenced (unused-component)
+`
+
+	assert.Equal(t, expected, b.String())
+	assert.True(t, lint.HasErrors(diagnostics))
+}
+
+func TestLintConfig(t *testing.T) {
+	t.Parallel()
+
+	_, diagnostics := run(t, &lint.Config{
+		Rules: map[string]lint.Severity{
+			"operation-id":          lint.SeverityWarning,
+			"success-response":      lint.SeverityOff,
+			"tags":                  lint.SeverityOff,
+			"inline-object":         lint.SeverityOff,
+			"operation-summary":     lint.SeverityOff,
+			"operation-description": lint.SeverityOff,
+			"error-response":        lint.SeverityInfo,
+			"unused-component":      lint.SeverityOff,
+		},
+	})
+
+	assert.Len(t, diagnostics, 4)
+	assert.False(t, lint.HasErrors(diagnostics))
+
+	for _, d := range diagnostics {
+		if d.Rule == "error-response" {
+			assert.Equal(t, lint.SeverityInfo, d.Severity)
+		}
+	}
+
+	if _, err := lint.New(lint.DefaultRules(), &lint.Config{
+		Rules: map[string]lint.Severity{"no-such-rule": lint.SeverityError},
+	}); !errors.Is(err, lint.ErrUnknownRule) {
+		t.Errorf("expected ErrUnknownRule, got %v", err)
+	}
+
+	if _, err := lint.New(lint.DefaultRules(), &lint.Config{
+		Rules: map[string]lint.Severity{"tags": "fatal"},
+	}); !errors.Is(err, lint.ErrUnknownSeverity) {
+		t.Errorf("expected ErrUnknownSeverity, got %v", err)
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	t.Parallel()
+
+	linter, diagnostics := run(t, nil)
+
+	var b bytes.Buffer
+	if err := lint.WriteSARIF(&b, "lint.yaml", linter, diagnostics[:1]); err != nil {
+		t.Fatalf("failed to write SARIF: %v", err)
+	}
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []json.RawMessage `json:"results"`
+		} `json:"runs"`
+	}
+
+	if err := json.Unmarshal(b.Bytes(), &log); err != nil {
+		t.Fatalf("failed to parse SARIF: %v", err)
+	}
+
+	assert.Equal(t, "2.1.0", log.Version)
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, len(lint.DefaultRules()))
+	assert.JSONEq(t, `{
+		"ruleId": "tags",
+		"level": "warning",
+		"message": {"text": "tag \"unused\" is not used by any operation"},
+		"locations": [{
+			"physicalLocation": {
+				"artifactLocation": {"uri": "lint.yaml"},
+				"region": {"startLine": 9, "startColumn": 11}
+			}
+		}]
+	}`, string(log.Runs[0].Results[0]))
+}
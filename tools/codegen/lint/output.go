@@ -0,0 +1,161 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// WriteText writes the diagnostics one per line as file:line:column: severity: message (rule).
+func WriteText(w io.Writer, file string, diagnostics []Diagnostic) error {
+	for _, d := range diagnostics {
+		if _, err := fmt.Fprintf(
+			w, "%s:%d:%d: %s: %s (%s)\n", file, d.Line, d.Column, d.Severity, d.Message, d.Rule,
+		); err != nil {
+			return fmt.Errorf("failed to write diagnostic: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+// sarifLevel maps the severity to the level of SARIF results.
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "note"
+	case SeverityOff:
+		return "none"
+	default:
+		return "none"
+	}
+}
+
+// WriteSARIF writes the diagnostics as a SARIF 2.1.0 log so they can be uploaded
+// to code review tools. file is the URI of the linted document relative to the
+// root of the repository.
+func WriteSARIF(w io.Writer, file string, linter *Linter, diagnostics []Diagnostic) error {
+	rules := make([]sarifRule, len(linter.Rules()))
+	for i, rule := range linter.Rules() {
+		rules[i] = sarifRule{
+			ID:                   rule.Name(),
+			ShortDescription:     sarifMessage{Text: rule.Description()},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(linter.Severity(rule.Name()))},
+		}
+	}
+
+	results := make([]sarifResult, len(diagnostics))
+	for i, d := range diagnostics {
+		location := sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: file},
+				Region:           nil,
+			},
+		}
+
+		if d.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegion{
+				StartLine:   d.Line,
+				StartColumn: max(d.Column, 1),
+			}
+		}
+
+		results[i] = sarifResult{
+			RuleID:    d.Rule,
+			Level:     sarifLevel(d.Severity),
+			Message:   sarifMessage{Text: d.Message},
+			Locations: []sarifLocation{location},
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:  "codegen",
+						Rules: rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to write SARIF log: %w", err)
+	}
+
+	return nil
+}
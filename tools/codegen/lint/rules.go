@@ -0,0 +1,341 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// excludeTag marks operations left out of the generated code.
+const excludeTag = "excludeme"
+
+var camelCase = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`) //nolint:gochecknoglobals
+
+// DefaultRules returns the rules enforcing the conventions of the project.
+func DefaultRules() []Rule {
+	return []Rule{
+		&OperationID{},
+		&OperationSummary{},
+		&OperationDescription{},
+		&SuccessResponse{},
+		&ErrorResponse{Schema: "ErrorResponse"},
+		&Tags{},
+		&InlineObject{},
+		&UnusedComponent{},
+	}
+}
+
+type operation struct {
+	path   string
+	method string
+	op     *v3.Operation
+	node   *yaml.Node
+}
+
+func (o operation) String() string {
+	if o.op.OperationId != "" {
+		return o.op.OperationId
+	}
+
+	return strings.ToUpper(o.method) + " " + o.path
+}
+
+func operations(doc *v3.Document) []operation {
+	ops := make([]operation, 0)
+
+	if doc.Paths == nil {
+		return ops
+	}
+
+	for pathPair := doc.Paths.PathItems.First(); pathPair != nil; pathPair = pathPair.Next() {
+		for opPair := pathPair.Value().GetOperations().First(); opPair != nil; opPair = opPair.Next() {
+			ops = append(ops, operation{
+				path:   pathPair.Key(),
+				method: opPair.Key(),
+				op:     opPair.Value(),
+				node:   opPair.Value().GoLow().KeyNode,
+			})
+		}
+	}
+
+	return ops
+}
+
+// orNode returns node unless it's nil, in which case it returns fallback.
+func orNode(node, fallback *yaml.Node) *yaml.Node {
+	if node != nil {
+		return node
+	}
+
+	return fallback
+}
+
+// schemaNode returns the node of the schema of a media type. References are
+// located where they are used rather than at the component they point to.
+func schemaNode(proxy *base.SchemaProxy) *yaml.Node {
+	low := proxy.GoLow()
+	if !low.IsReference() {
+		return low.GetValueNode()
+	}
+
+	if node := low.GetReferenceNode(); node != nil && node.Line > 0 {
+		return node
+	}
+
+	return low.GetKeyNode()
+}
+
+// OperationID checks that every operation has a unique camelCase operationId,
+// which is used to name the methods of the generated clients.
+type OperationID struct{}
+
+func (r *OperationID) Name() string {
+	return "operation-id"
+}
+
+func (r *OperationID) Description() string {
+	return "Operations have a unique camelCase operationId"
+}
+
+func (r *OperationID) DefaultSeverity() Severity {
+	return SeverityError
+}
+
+func (r *OperationID) Check(doc *v3.Document, report Report) {
+	seen := make(map[string]operation)
+
+	for _, o := range operations(doc) {
+		id := o.op.OperationId
+		node := orNode(o.op.GoLow().OperationId.ValueNode, o.node)
+
+		switch {
+		case id == "":
+			report(o.node, o.String()+" has no operationId")
+		case !camelCase.MatchString(id):
+			report(node, fmt.Sprintf("operationId %q is not camelCase", id))
+		}
+
+		if prev, ok := seen[id]; ok && id != "" {
+			report(node, fmt.Sprintf(
+				"operationId %q is already used by %s %s", id, strings.ToUpper(prev.method), prev.path,
+			))
+		}
+
+		seen[id] = o
+	}
+}
+
+// OperationSummary checks that every operation has a summary.
+type OperationSummary struct{}
+
+func (r *OperationSummary) Name() string {
+	return "operation-summary"
+}
+
+func (r *OperationSummary) Description() string {
+	return "Operations have a summary"
+}
+
+func (r *OperationSummary) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+func (r *OperationSummary) Check(doc *v3.Document, report Report) {
+	for _, o := range operations(doc) {
+		if strings.TrimSpace(o.op.Summary) == "" {
+			report(o.node, o.String()+" has no summary")
+		}
+	}
+}
+
+// OperationDescription checks that every operation has a description, which
+// becomes the documentation of the generated methods.
+type OperationDescription struct{}
+
+func (r *OperationDescription) Name() string {
+	return "operation-description"
+}
+
+func (r *OperationDescription) Description() string {
+	return "Operations have a description"
+}
+
+func (r *OperationDescription) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+func (r *OperationDescription) Check(doc *v3.Document, report Report) {
+	for _, o := range operations(doc) {
+		if strings.TrimSpace(o.op.Description) == "" {
+			report(o.node, o.String()+" has no description")
+		}
+	}
+}
+
+// SuccessResponse checks that every operation documents a 2XX or 3XX response,
+// which determines the return type of the generated methods.
+type SuccessResponse struct{}
+
+func (r *SuccessResponse) Name() string {
+	return "success-response"
+}
+
+func (r *SuccessResponse) Description() string {
+	return "Operations document a successful (2XX or 3XX) response"
+}
+
+func (r *SuccessResponse) DefaultSeverity() Severity {
+	return SeverityError
+}
+
+func (r *SuccessResponse) Check(doc *v3.Document, report Report) {
+	for _, o := range operations(doc) {
+		found := false
+
+		if o.op.Responses != nil {
+			for codePair := o.op.Responses.Codes.First(); codePair != nil; codePair = codePair.Next() {
+				if strings.HasPrefix(codePair.Key(), "2") || strings.HasPrefix(codePair.Key(), "3") {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			report(orNode(o.op.GoLow().Responses.KeyNode, o.node), o.String()+" has no successful response")
+		}
+	}
+}
+
+// ErrorResponse checks that the JSON bodies of error responses (4XX, 5XX and
+// default) reference the shared error schema so clients can parse them the same way.
+type ErrorResponse struct {
+	// Schema is the name of the component schema error responses must reference
+	Schema string
+}
+
+func (r *ErrorResponse) Name() string {
+	return "error-response"
+}
+
+func (r *ErrorResponse) Description() string {
+	return "JSON error responses reference the " + r.Schema + " schema"
+}
+
+func (r *ErrorResponse) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+func (r *ErrorResponse) Check(doc *v3.Document, report Report) {
+	for _, o := range operations(doc) {
+		if o.op.Responses == nil {
+			continue
+		}
+
+		responses := make(map[string]*v3.Response)
+		codes := make([]string, 0)
+
+		for codePair := o.op.Responses.Codes.First(); codePair != nil; codePair = codePair.Next() {
+			if strings.HasPrefix(codePair.Key(), "4") || strings.HasPrefix(codePair.Key(), "5") {
+				responses[codePair.Key()] = codePair.Value()
+				codes = append(codes, codePair.Key())
+			}
+		}
+
+		if o.op.Responses.Default != nil {
+			responses["default"] = o.op.Responses.Default
+			codes = append(codes, "default")
+		}
+
+		for _, code := range codes {
+			for mediaPair := responses[code].Content.First(); mediaPair != nil; mediaPair = mediaPair.Next() {
+				schema := mediaPair.Value().Schema
+				if !strings.Contains(mediaPair.Key(), "json") || schema == nil {
+					continue
+				}
+
+				if schema.IsReference() && format.GetNameFromComponentRef(schema.GetReference()) == r.Schema {
+					continue
+				}
+
+				report(
+					orNode(schemaNode(schema), o.node),
+					fmt.Sprintf("response %s of %s doesn't reference %s", code, o, r.Schema),
+				)
+			}
+		}
+	}
+}
+
+// Tags checks that operations are tagged with tags declared at the top of the
+// document and that every declared tag is used. The excludeme tag, which leaves
+// operations out of the generated code, must be declared like any other tag and
+// doesn't replace the tag grouping the operation in the documentation.
+type Tags struct{}
+
+func (r *Tags) Name() string {
+	return "tags"
+}
+
+func (r *Tags) Description() string {
+	return "Operations are tagged with declared tags and every declared tag is used"
+}
+
+func (r *Tags) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+func (r *Tags) Check(doc *v3.Document, report Report) {
+	declared := make(map[string]bool, len(doc.Tags))
+	used := make(map[string]bool)
+
+	for _, o := range operations(doc) {
+		low := o.op.GoLow().Tags.Value
+		switch {
+		case len(o.op.Tags) == 0:
+			report(o.node, o.String()+" has no tags")
+		case len(o.op.Tags) == 1 && o.op.Tags[0] == excludeTag:
+			report(o.node, o.String()+" is only tagged "+excludeTag)
+		}
+
+		for i, tag := range o.op.Tags {
+			used[tag] = true
+
+			if !declaresTag(doc, tag) {
+				var node *yaml.Node
+				if i < len(low) {
+					node = low[i].ValueNode
+				}
+
+				report(orNode(node, o.node), fmt.Sprintf("tag %q of %s is not declared", tag, o))
+			}
+		}
+	}
+
+	for _, tag := range doc.Tags {
+		if declared[tag.Name] {
+			report(tag.GoLow().Name.ValueNode, fmt.Sprintf("tag %q is declared more than once", tag.Name))
+		}
+
+		declared[tag.Name] = true
+
+		if !used[tag.Name] {
+			report(tag.GoLow().Name.ValueNode, fmt.Sprintf("tag %q is not used by any operation", tag.Name))
+		}
+	}
+}
+
+func declaresTag(doc *v3.Document, name string) bool {
+	for _, tag := range doc.Tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
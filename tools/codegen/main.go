@@ -5,7 +5,11 @@ import (
 	"log"
 	"os"
 
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/bundle"
 	"github.com/nhost/sdk-experiment/tools/codegen/cmd/gen"
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/lint"
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/mock"
+	"github.com/nhost/sdk-experiment/tools/codegen/cmd/sample"
 	"github.com/urfave/cli/v3"
 )
 
@@ -18,6 +22,10 @@ func main() {
 		Usage:   "make an explosive entrance",
 		Commands: []*cli.Command{
 			gen.Command(),
+			mock.Command(),
+			sample.Command(),
+			lint.Command(),
+			bundle.Command(),
 		},
 	}
 
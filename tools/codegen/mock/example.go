@@ -0,0 +1,66 @@
+package mock
+
+import (
+	"encoding/json"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/sample"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+func jsonString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "null"
+	}
+
+	return string(b)
+}
+
+func decodeNode(node *yaml.Node) (any, bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return nil, false
+	}
+
+	return v, true
+}
+
+// firstExample returns the example set directly or the first of the named examples.
+func firstExample(example *yaml.Node, examples *orderedmap.Map[string, *base.Example]) (any, bool) {
+	if v, ok := decodeNode(example); ok {
+		return v, true
+	}
+
+	if examples == nil {
+		return nil, false
+	}
+
+	for pair := examples.First(); pair != nil; pair = pair.Next() {
+		if pair.Value() == nil {
+			continue
+		}
+
+		if v, ok := decodeNode(pair.Value().Value); ok {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// generate returns a value of the type. Examples in the schemas are used when
+// present, otherwise a sample is generated. The generator is seeded the same way
+// for every response so the server always answers with the same values.
+func generate(t processor.Type) any {
+	g := sample.New(0)
+	g.Examples = true
+
+	return g.Value(t)
+}
@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/sample"
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+)
+
+// TestSamplesValidate checks that the values the server generates are accepted
+// by the validation of requests.
+func TestSamplesValidate(t *testing.T) {
+	t.Parallel()
+
+	paths := []string{
+		"testdata/pets.yaml",
+		"../processor/testdata/constraints.yaml",
+		"../processor/testdata/types.yaml",
+		"../../../packages/nhost-js/api/auth.yaml",
+		"../../../packages/nhost-js/api/storage.yaml",
+	}
+
+	for _, path := range paths {
+		doc, err := spec.Load(path)
+		if err != nil {
+			t.Fatalf("failed to load %s: %v", path, err)
+		}
+
+		ir, err := processor.NewInterMediateRepresentation(doc, &processor.DocumentNames{})
+		if err != nil {
+			t.Fatalf("failed to create intermediate representation of %s: %v", path, err)
+		}
+
+		for seed := range uint64(20) {
+			g := sample.New(seed)
+
+			for _, typ := range ir.Types {
+				if issues := validate(typ, normalize(g.Value(typ)), typ.Name(), nil); len(issues) > 0 {
+					t.Errorf("%s: seed %d: invalid sample of %s: %v", path, seed, typ.Name(), issues)
+				}
+			}
+		}
+	}
+}
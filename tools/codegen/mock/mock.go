@@ -0,0 +1,350 @@
+// Package mock implements an HTTP server answering the operations of an OpenAPI
+// document with the examples of the spec, so SDKs can be tested offline.
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+var ErrUnknownOperation = errors.New("unknown operation")
+
+// Server routes requests to the methods of the intermediate representation,
+// validates their inputs and answers with the first successful response of the
+// operation unless the scenario overrides it.
+type Server struct {
+	methods  []*processor.Method
+	scenario *Scenario
+}
+
+// New returns a server for the methods of the intermediate representation. The
+// scenario is optional.
+func New(ir *processor.InterMediateRepresentation, scenario *Scenario) (*Server, error) {
+	methods := slices.Clone(ir.Methods)
+
+	// routes with more literal characters are more specific and are tried first
+	sort.SliceStable(methods, func(i, j int) bool {
+		return literalLength(methods[i]) > literalLength(methods[j])
+	})
+
+	if scenario != nil {
+		for operationID := range scenario.Operations {
+			if !slices.ContainsFunc(methods, func(m *processor.Method) bool {
+				return m.Operation.OperationId == operationID
+			}) {
+				return nil, fmt.Errorf(
+					"%w: scenario references operation %s", ErrUnknownOperation, operationID,
+				)
+			}
+		}
+	}
+
+	return &Server{
+		methods:  methods,
+		scenario: scenario,
+	}, nil
+}
+
+func literalLength(m *processor.Method) int {
+	n := 0
+	for _, segment := range m.PathSegments() {
+		n += len(segment.Literal)
+	}
+
+	return n
+}
+
+// matchPath returns the raw value of each path parameter if the escaped path
+// matches the segments of the method.
+func matchPath(segments []*processor.PathSegment, path string) (map[string]string, bool) {
+	values := make(map[string]string)
+	rest := path
+
+	for i, segment := range segments {
+		if !segment.IsParameter() {
+			var ok bool
+			if rest, ok = strings.CutPrefix(rest, segment.Literal); !ok {
+				return nil, false
+			}
+
+			continue
+		}
+
+		// parameter values never span several path segments
+		end := strings.IndexByte(rest, '/')
+		if end == -1 {
+			end = len(rest)
+		}
+
+		if i+1 < len(segments) {
+			// the value of label and matrix parameters starts with their own delimiter
+			if delimiter := pathDelimiter(segments[i+1]); delimiter != "" && end > 0 {
+				if j := strings.Index(rest[1:end], delimiter); j != -1 {
+					end = j + 1
+				}
+			}
+		}
+
+		if end == 0 && segment.Parameter.Required() {
+			return nil, false
+		}
+
+		values[segment.Parameter.WireName()] = rest[:end]
+		rest = rest[end:]
+	}
+
+	return values, rest == ""
+}
+
+// route returns the method matching the request and the raw values of its path
+// parameters. If the path matches but the method doesn't, allowed lists the
+// methods accepted for the path.
+func (s *Server) route(r *http.Request) (*processor.Method, map[string]string, []string) {
+	allowed := make([]string, 0)
+
+	for _, m := range s.methods {
+		values, ok := matchPath(m.PathSegments(), r.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+
+		if m.Method() != r.Method {
+			allowed = append(allowed, m.Method())
+			continue
+		}
+
+		return m, values, nil
+	}
+
+	return nil, nil, allowed
+}
+
+type errorResponse struct {
+	Error  string  `json:"error"`
+	Issues []Issue `json:"issues,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m, pathValues, allowed := s.route(r)
+	if m == nil {
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeJSON(w, http.StatusMethodNotAllowed, errorResponse{
+				Error:  fmt.Sprintf("method %s not allowed for %s", r.Method, r.URL.Path),
+				Issues: nil,
+			})
+
+			return
+		}
+
+		writeJSON(w, http.StatusNotFound, errorResponse{
+			Error:  fmt.Sprintf("no operation matches %s %s", r.Method, r.URL.Path),
+			Issues: nil,
+		})
+
+		return
+	}
+
+	if issues := validateRequest(m, pathValues, r); len(issues) > 0 {
+		writeJSON(w, http.StatusBadRequest, errorResponse{
+			Error:  "request validation failed for " + m.Operation.OperationId,
+			Issues: issues,
+		})
+
+		return
+	}
+
+	s.respond(w, m)
+}
+
+// DefaultStatus returns the first successful status code of the method, falling
+// back to the first redirect for methods that only redirect.
+func DefaultStatus(m *processor.Method) int {
+	code := ""
+	if responses := m.SuccessResponsesByCode(); len(responses) > 0 {
+		code = responses[0].Code
+	} else if responses := m.ErrorResponses(); len(responses) > 0 {
+		code = responses[0].Code
+	}
+
+	if status, err := strconv.Atoi(code); err == nil && status < http.StatusBadRequest {
+		return status
+	}
+
+	return http.StatusOK
+}
+
+// specResponse returns the response documented for the status, or the default one.
+func specResponse(m *processor.Method, status int) *v3.Response {
+	if m.Operation.Responses == nil {
+		return nil
+	}
+
+	if resp := m.Operation.Responses.Codes.GetOrZero(strconv.Itoa(status)); resp != nil {
+		return resp
+	}
+
+	return m.Operation.Responses.Default
+}
+
+// responseBody returns the media type and body of the documented response. The
+// example of the media type is preferred over the examples of the schema.
+func responseBody(m *processor.Method, status int) (string, any, bool) {
+	resp := specResponse(m, status)
+	if resp == nil || resp.Content == nil {
+		return "", nil, false
+	}
+
+	pair := resp.Content.First()
+	if pair == nil {
+		return "", nil, false
+	}
+
+	mediaType, content := pair.Key(), pair.Value()
+
+	if v, ok := firstExample(content.Example, content.Examples); ok {
+		return mediaType, v, true
+	}
+
+	body, ok := m.Responses[strconv.Itoa(status)]
+	if !ok {
+		body = m.DefaultResponse
+	}
+
+	if t := body[mediaType]; t != nil {
+		return mediaType, generate(t), true
+	}
+
+	return mediaType, nil, false
+}
+
+func responseHeaders(m *processor.Method, status int) map[string]string {
+	headers := make(map[string]string)
+
+	for _, h := range m.ResponseHeaders[strconv.Itoa(status)] {
+		// the length is set by net/http from the body actually written
+		if strings.EqualFold(h.Name(), "content-length") {
+			continue
+		}
+
+		v, ok := firstExample(h.Header.Example, h.Header.Examples)
+		if !ok {
+			v = generate(h.Type)
+		}
+
+		if s, ok := v.(string); ok {
+			headers[h.Name()] = s
+		} else {
+			headers[h.Name()] = jsonString(v)
+		}
+	}
+
+	return headers
+}
+
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// Example is a response of an operation built from the examples of the spec.
+type Example struct {
+	Status    int
+	MediaType string
+	Headers   map[string]string
+	Body      any
+	// HasBody is false if the response has no content
+	HasBody bool
+}
+
+// ResponseExample returns the response documented for the status, built from the
+// examples of the spec and generated values where there are none.
+func ResponseExample(m *processor.Method, status int) *Example {
+	mediaType, body, hasBody := responseBody(m, status)
+
+	return &Example{
+		Status:    status,
+		MediaType: mediaType,
+		Headers:   responseHeaders(m, status),
+		Body:      body,
+		HasBody:   hasBody,
+	}
+}
+
+func (s *Server) respond(w http.ResponseWriter, m *processor.Method) {
+	status := DefaultStatus(m)
+
+	override := s.scenario.response(m.Operation.OperationId)
+	if override != nil && override.Status != 0 {
+		status = override.Status
+	}
+
+	example := ResponseExample(m, status)
+	headers, mediaType, body, hasBody := example.Headers, example.MediaType, example.Body, example.HasBody
+
+	if override != nil {
+		for k, v := range override.Headers {
+			headers[k] = v
+		}
+
+		if override.Body != nil {
+			body, hasBody = override.Body, true
+			if mediaType == "" {
+				mediaType = "application/json"
+			}
+		}
+	}
+
+	for k, v := range headers {
+		w.Header().Set(k, v)
+	}
+
+	if mediaType != "" && w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", mediaType)
+	}
+
+	if !hasBody {
+		w.WriteHeader(status)
+		return
+	}
+
+	var b []byte
+
+	switch body := body.(type) {
+	case string:
+		if isJSON(mediaType) {
+			b = []byte(jsonString(body))
+		} else {
+			b = []byte(body)
+		}
+	default:
+		var err error
+		if b, err = json.Marshal(body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
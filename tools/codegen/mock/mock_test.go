@@ -0,0 +1,284 @@
+package mock_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/mock"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func getIR(t *testing.T, path string) *processor.InterMediateRepresentation {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	document, err := libopenapi.NewDocument(b)
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	docModel, errs := document.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("failed to build model: %v", errs)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(docModel, &processor.DocumentNames{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	return ir
+}
+
+type response struct {
+	status  int
+	headers map[string]string
+	body    string
+}
+
+func do(t *testing.T, server http.Handler, method, target, body string, headers map[string]string) response {
+	t.Helper()
+
+	var r *http.Request
+	if body != "" {
+		r = httptest.NewRequest(method, target, strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+	} else {
+		r = httptest.NewRequest(method, target, nil)
+	}
+
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, r)
+
+	got := response{
+		status:  w.Code,
+		headers: make(map[string]string),
+		body:    w.Body.String(),
+	}
+
+	for k := range w.Header() {
+		got.headers[k] = w.Header().Get(k)
+	}
+
+	return got
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	server, err := mock.New(getIR(t, "testdata/pets.yaml"), nil)
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+
+	trace := map[string]string{"X-Trace": "3fa85f64-5717-4562-b3fc-2c963f66afa6"}
+
+	cases := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		headers    map[string]string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "synthesized",
+			method:     http.MethodGet,
+			target:     "/pets?limit=10&tags=a&tags=b",
+			body:       "",
+			headers:    nil,
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"id":343,"kind":"dog","name":"juliett bravo"}]`,
+		},
+		{
+			name:       "example",
+			method:     http.MethodPost,
+			target:     "/pets",
+			body:       `{"name":"Rex","kind":"dog"}`,
+			headers:    nil,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":1,"kind":"dog","name":"Rex"}`,
+		},
+		{
+			name:       "invalid query",
+			method:     http.MethodGet,
+			target:     "/pets?limit=0",
+			body:       "",
+			headers:    nil,
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"error":"request validation failed for listPets","issues":[` +
+				`{"path":"query.limit","message":"must be at least 1"}]}`,
+		},
+		{
+			name:       "invalid body",
+			method:     http.MethodPost,
+			target:     "/pets",
+			body:       `{"name":"R","kind":"bird","email":"nope"}`,
+			headers:    nil,
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"error":"request validation failed for createPet","issues":[` +
+				`{"path":"body.name","message":"must be at least 2 characters long"},` +
+				`{"path":"body.kind","message":"must be one of \"cat\", \"dog\""},` +
+				`{"path":"body.email","message":"must be a valid email"}]}`,
+		},
+		{
+			name:       "missing body",
+			method:     http.MethodPost,
+			target:     "/pets",
+			body:       "",
+			headers:    nil,
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"error":"request validation failed for createPet","issues":[` +
+				`{"path":"body","message":"is required"}]}`,
+		},
+		{
+			name:       "path and header",
+			method:     http.MethodGet,
+			target:     "/pets/abc",
+			body:       "",
+			headers:    nil,
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"error":"request validation failed for getPet","issues":[` +
+				`{"path":"path.id","message":"must be a number"},` +
+				`{"path":"header.X-Trace","message":"is required"}]}`,
+		},
+		{
+			name:       "valid path and header",
+			method:     http.MethodGet,
+			target:     "/pets/1",
+			body:       "",
+			headers:    trace,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"email":"lima.romeo@example.com","kind":"cat","name":"india kilo juliett"}`,
+		},
+		{
+			name:       "method not allowed",
+			method:     http.MethodDelete,
+			target:     "/pets/1",
+			body:       "",
+			headers:    nil,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   `{"error":"method DELETE not allowed for /pets/1"}`,
+		},
+		{
+			name:       "not found",
+			method:     http.MethodGet,
+			target:     "/owners",
+			body:       "",
+			headers:    nil,
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error":"no operation matches GET /owners"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := do(t, server, tc.method, tc.target, tc.body, tc.headers)
+
+			assert.Equal(t, tc.wantStatus, got.status)
+			assert.JSONEq(t, tc.wantBody, got.body)
+		})
+	}
+
+	got := do(t, server, http.MethodGet, "/pets/1", "", trace)
+	assert.Equal(t, "42", got.headers["X-Rate-Limit"])
+}
+
+func TestServerScenario(t *testing.T) {
+	t.Parallel()
+
+	scenario := &mock.Scenario{
+		Operations: map[string]*mock.Response{
+			"createPet": {
+				Status:  http.StatusConflict,
+				Headers: map[string]string{"X-Request-Id": "abc"},
+				Body:    nil,
+			},
+			"listPets": {
+				Status:  0,
+				Headers: nil,
+				Body:    []any{},
+			},
+		},
+	}
+
+	server, err := mock.New(getIR(t, "testdata/pets.yaml"), scenario)
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+
+	got := do(t, server, http.MethodPost, "/pets", `{"name":"Rex","kind":"dog"}`, nil)
+	assert.Equal(t, http.StatusConflict, got.status)
+	assert.Equal(t, "abc", got.headers["X-Request-Id"])
+	assert.JSONEq(t, `{"message":"something went wrong"}`, got.body)
+
+	got = do(t, server, http.MethodGet, "/pets", "", nil)
+	assert.Equal(t, http.StatusOK, got.status)
+	assert.JSONEq(t, `[]`, got.body)
+
+	_, err = mock.New(getIR(t, "testdata/pets.yaml"), &mock.Scenario{
+		Operations: map[string]*mock.Response{"deletePet": {Status: 0, Headers: nil, Body: nil}},
+	})
+	if !errors.Is(err, mock.ErrUnknownOperation) {
+		t.Errorf("expected ErrUnknownOperation, got %v", err)
+	}
+}
+
+func TestLoadScenario(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "/scenario.json"
+	if err := os.WriteFile(
+		path, []byte(`{"operations": {"getPet": {"status": 404, "body": {"message": "gone"}}}}`), 0o600,
+	); err != nil {
+		t.Fatalf("failed to write scenario: %v", err)
+	}
+
+	scenario, err := mock.LoadScenario(path)
+	if err != nil {
+		t.Fatalf("failed to load scenario: %v", err)
+	}
+
+	b, err := json.Marshal(scenario.Operations["getPet"].Body)
+	if err != nil {
+		t.Fatalf("failed to marshal body: %v", err)
+	}
+
+	assert.Equal(t, http.StatusNotFound, scenario.Operations["getPet"].Status)
+	assert.JSONEq(t, `{"message":"gone"}`, string(b))
+}
+
+func TestServerPathStyles(t *testing.T) {
+	t.Parallel()
+
+	server, err := mock.New(getIR(t, "../processor/testdata/path_styles.yaml"), nil)
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+
+	got := do(t, server, http.MethodGet, "/buckets/default/files/a,b.txt.1.2;tags=x,y;lat=1;lng=2", "", nil)
+	assert.Equal(t, http.StatusNoContent, got.status, got.body)
+
+	got = do(t, server, http.MethodGet, "/buckets/default/files/a,b.txt.1.x;tags=x,y;lat=1;lng=2", "", nil)
+	assert.Equal(t, http.StatusBadRequest, got.status)
+	assert.JSONEq(t, `{"error":"request validation failed for getBucketFile","issues":[`+
+		`{"path":"path.coords[1]","message":"must be a number"}]}`, got.body)
+}
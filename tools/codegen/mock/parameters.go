@@ -0,0 +1,247 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func unescapePath(s string) string {
+	if v, err := url.PathUnescape(s); err == nil {
+		return v
+	}
+
+	return s
+}
+
+func unescapeNone(s string) string {
+	return s
+}
+
+// coerce converts a serialized scalar into the JSON value expected by its schema.
+// Values that can't be converted are returned as is so validation reports them.
+func coerce(t processor.Type, raw string) any {
+	switch processor.ScalarType(t) {
+	case "integer", "number":
+		if f, err := strconv.ParseFloat(raw, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(raw); err == nil {
+			return b
+		}
+	}
+
+	return raw
+}
+
+func propertyType(t processor.Type, name string) processor.Type { //nolint:ireturn
+	obj, ok := t.(*processor.TypeObject)
+	if !ok {
+		return nil
+	}
+
+	for _, prop := range obj.Properties() {
+		if prop.WireName() == name {
+			return prop.Type
+		}
+	}
+
+	return nil
+}
+
+func decodeObject(t processor.Type, pairs map[string]string) map[string]any {
+	record := make(map[string]any, len(pairs))
+	for k, v := range pairs {
+		record[k] = coerce(propertyType(t, k), v)
+	}
+
+	return record
+}
+
+func isObject(t processor.Type) bool {
+	switch t.(type) {
+	case *processor.TypeObject, *processor.TypeMap:
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeStyled decodes a value serialized with one of the OpenAPI styles once its
+// prefix has been removed. Arrays are split by sep. Objects are split by sep into
+// key=value pairs when exploded and into alternating keys and values otherwise.
+func decodeStyled(
+	t processor.Type, raw string, sep string, explode bool, unescape func(string) string,
+) any {
+	switch t := t.(type) {
+	case *processor.TypeArray:
+		if raw == "" {
+			return []any{}
+		}
+
+		parts := strings.Split(raw, sep)
+
+		items := make([]any, len(parts))
+		for i, part := range parts {
+			items[i] = coerce(t.Item, unescape(part))
+		}
+
+		return items
+	case *processor.TypeObject, *processor.TypeMap:
+		pairs := make(map[string]string)
+		parts := strings.Split(raw, sep)
+
+		if explode {
+			for _, part := range parts {
+				k, v, _ := strings.Cut(part, "=")
+				pairs[unescape(k)] = unescape(v)
+			}
+		} else {
+			for i := 0; i+1 < len(parts); i += 2 {
+				pairs[unescape(parts[i])] = unescape(parts[i+1])
+			}
+		}
+
+		return decodeObject(t, pairs)
+	default:
+		return coerce(t, unescape(raw))
+	}
+}
+
+// decodePathParameter decodes the raw, still escaped, value of a path parameter.
+func decodePathParameter(param *processor.Parameter, raw string) any {
+	name := param.WireName()
+	explode := param.Explode()
+
+	switch param.Style() { //nolint:exhaustive
+	case processor.ParameterStyleLabel:
+		sep := ","
+		if explode {
+			sep = "."
+		}
+
+		return decodeStyled(param.Type, strings.TrimPrefix(raw, "."), sep, explode, unescapePath)
+	case processor.ParameterStyleMatrix:
+		if explode && isObject(param.Type) {
+			return decodeStyled(param.Type, strings.TrimPrefix(raw, ";"), ";", true, unescapePath)
+		}
+
+		sep := ","
+		if explode {
+			sep = ";" + name + "="
+		}
+
+		return decodeStyled(
+			param.Type, strings.TrimPrefix(raw, ";"+name+"="), sep, explode, unescapePath,
+		)
+	default:
+		return decodeStyled(param.Type, raw, ",", explode, unescapePath)
+	}
+}
+
+// pathDelimiter returns the string that starts the serialization of a segment,
+// used to find where the value of the previous parameter ends.
+func pathDelimiter(segment *processor.PathSegment) string {
+	if !segment.IsParameter() {
+		return segment.Literal
+	}
+
+	switch segment.Parameter.Style() { //nolint:exhaustive
+	case processor.ParameterStyleLabel:
+		return "."
+	case processor.ParameterStyleMatrix:
+		if segment.Parameter.Explode() && isObject(segment.Parameter.Type) {
+			return ";"
+		}
+
+		return ";" + segment.Parameter.WireName() + "="
+	default:
+		return ""
+	}
+}
+
+// decodeQueryParameter returns the decoded value of a query parameter and
+// whether it was present in the query string.
+func decodeQueryParameter(param *processor.Parameter, query url.Values) (any, bool) {
+	name := param.WireName()
+
+	if param.IsContent() {
+		if !query.Has(name) {
+			return nil, false
+		}
+
+		var v any
+		if err := json.Unmarshal([]byte(query.Get(name)), &v); err != nil {
+			return query.Get(name), true
+		}
+
+		return v, true
+	}
+
+	if param.Style() == processor.ParameterStyleDeepObject {
+		pairs := make(map[string]string)
+
+		for k, v := range query {
+			if prop, ok := strings.CutPrefix(k, name+"["); ok && strings.HasSuffix(prop, "]") {
+				pairs[strings.TrimSuffix(prop, "]")] = v[0]
+			}
+		}
+
+		return decodeObject(param.Type, pairs), len(pairs) > 0
+	}
+
+	if obj, ok := param.Type.(*processor.TypeObject); ok && param.Explode() {
+		pairs := make(map[string]string)
+
+		for _, prop := range obj.Properties() {
+			if query.Has(prop.WireName()) {
+				pairs[prop.WireName()] = query.Get(prop.WireName())
+			}
+		}
+
+		return decodeObject(param.Type, pairs), len(pairs) > 0
+	}
+
+	values, ok := query[name]
+	if !ok {
+		return nil, false
+	}
+
+	if array, ok := param.Type.(*processor.TypeArray); ok && param.Explode() {
+		items := make([]any, len(values))
+		for i, v := range values {
+			items[i] = coerce(array.Item, v)
+		}
+
+		return items, true
+	}
+
+	sep := ","
+
+	switch param.Style() { //nolint:exhaustive
+	case processor.ParameterStyleSpaceDelimited:
+		sep = " "
+	case processor.ParameterStylePipeDelimited:
+		sep = "|"
+	}
+
+	return decodeStyled(param.Type, values[0], sep, false, unescapeNone), true
+}
+
+// decodeHeaderParameter returns the decoded value of a header parameter and
+// whether it was sent.
+func decodeHeaderParameter(param *processor.Parameter, header http.Header) (any, bool) {
+	values := header.Values(param.WireName())
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	return decodeStyled(
+		param.Type, strings.Join(values, ","), ",", param.Explode(), unescapeNone,
+	), true
+}
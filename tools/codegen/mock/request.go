@@ -0,0 +1,175 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+const maxMemory = 32 << 20
+
+// validateRequest checks the parameters and body of a request routed to the method.
+// pathValues holds the raw values of the path parameters by wire name.
+func validateRequest(m *processor.Method, pathValues map[string]string, r *http.Request) []Issue {
+	issues := make([]Issue, 0)
+
+	for _, param := range m.Parameters {
+		var (
+			value   any
+			present bool
+			path    = param.Parameter.In + "." + param.WireName()
+		)
+
+		switch param.Parameter.In {
+		case "path":
+			var raw string
+
+			raw, present = pathValues[param.WireName()]
+			if present {
+				value = decodePathParameter(param, raw)
+			}
+		case "query":
+			value, present = decodeQueryParameter(param, r.URL.Query())
+		case "header":
+			value, present = decodeHeaderParameter(param, r.Header)
+		default:
+			continue
+		}
+
+		if !present {
+			if param.Required() {
+				issues = append(issues, Issue{Path: path, Message: "is required"})
+			}
+
+			continue
+		}
+
+		issues = validate(param.Type, value, path, issues)
+	}
+
+	return validateBody(m, r, issues)
+}
+
+func validateBody(m *processor.Method, r *http.Request, issues []Issue) []Issue {
+	if !m.RequestHasBody() {
+		return issues
+	}
+
+	if r.ContentLength == 0 || r.Body == nil || r.Body == http.NoBody {
+		if m.BodyRequired {
+			return append(issues, Issue{Path: "body", Message: "is required"})
+		}
+
+		return issues
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return append(issues, Issue{Path: "body", Message: "must have a valid content type"})
+	}
+
+	t, ok := m.Bodies[mediaType]
+	if !ok {
+		return append(issues, Issue{Path: "body", Message: "must not be sent as " + mediaType})
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var value any
+
+		err := json.NewDecoder(r.Body).Decode(&value)
+
+		switch {
+		case errors.Is(err, io.EOF) && m.BodyRequired:
+			return append(issues, Issue{Path: "body", Message: "is required"})
+		case errors.Is(err, io.EOF):
+			return issues
+		case err != nil:
+			return append(issues, Issue{Path: "body", Message: "must be valid JSON"})
+		}
+
+		return validate(t, value, "body", issues)
+	case mediaType == "multipart/form-data":
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			return append(issues, Issue{Path: "body", Message: "must be a valid multipart form"})
+		}
+
+		return validateForm(t, r.MultipartForm.Value, r.MultipartForm.File != nil, r, issues)
+	case mediaType == "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return append(issues, Issue{Path: "body", Message: "must be a valid form"})
+		}
+
+		return validateForm(t, r.PostForm, false, r, issues)
+	default:
+		return issues
+	}
+}
+
+// validateForm checks the fields of a form body. Files only need to be present,
+// other fields are decoded like exploded query parameters.
+func validateForm(
+	t processor.Type, values url.Values, hasFiles bool, r *http.Request, issues []Issue,
+) []Issue {
+	obj, ok := t.(*processor.TypeObject)
+	if !ok {
+		return issues
+	}
+
+	for _, prop := range obj.Properties() {
+		name := prop.WireName()
+		path := "body." + name
+
+		fieldValues, present := values[name]
+		if !present && hasFiles {
+			_, present = r.MultipartForm.File[name]
+			if present {
+				continue
+			}
+		}
+
+		if !present {
+			if prop.Required() {
+				issues = append(issues, Issue{Path: path, Message: "is required"})
+			}
+
+			continue
+		}
+
+		var value any
+		if array, ok := prop.Type.(*processor.TypeArray); ok {
+			items := make([]any, len(fieldValues))
+			for i, v := range fieldValues {
+				items[i] = formValue(array.Item, v)
+			}
+
+			value = items
+		} else {
+			value = formValue(prop.Type, fieldValues[0])
+		}
+
+		issues = validate(prop.Type, value, path, issues)
+	}
+
+	return issues
+}
+
+// formValue decodes a form field. Objects are sent as JSON, scalars as text.
+func formValue(t processor.Type, raw string) any {
+	if isObject(t) {
+		var v any
+		if err := json.Unmarshal([]byte(raw), &v); err == nil {
+			return v
+		}
+
+		return raw
+	}
+
+	return coerce(t, raw)
+}
@@ -0,0 +1,54 @@
+package mock
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario overrides the responses of the mock server per operationId. It is
+// read from YAML or JSON files like:
+//
+//	operations:
+//	  signInEmailPassword:
+//	    status: 401
+//	    headers:
+//	      X-Request-Id: abc
+//	    body:
+//	      error: invalid-email-password
+type Scenario struct {
+	Operations map[string]*Response `json:"operations" yaml:"operations"`
+}
+
+// Response replaces the default response of an operation. Fields left empty
+// fall back to the status, headers and body derived from the spec, using the
+// response documented for the given status.
+type Response struct {
+	Status  int               `json:"status"  yaml:"status"`
+	Headers map[string]string `json:"headers" yaml:"headers"`
+	Body    any               `json:"body"    yaml:"body"`
+}
+
+// LoadScenario reads a scenario file.
+func LoadScenario(path string) (*Scenario, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario file: %w", err)
+	}
+
+	var scenario Scenario
+	if err := yaml.Unmarshal(b, &scenario); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario file: %w", err)
+	}
+
+	return &scenario, nil
+}
+
+func (s *Scenario) response(operationID string) *Response {
+	if s == nil {
+		return nil
+	}
+
+	return s.Operations[operationID]
+}
@@ -0,0 +1,266 @@
+package mock
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+// Issue is a problem found while validating a request.
+type Issue struct {
+	// Path is the location of the invalid value, e.g. body.options.locale
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+var formats = map[string]*regexp.Regexp{ //nolint:gochecknoglobals
+	"email": regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`),
+	"uri":   regexp.MustCompile(`^[a-zA-Z][a-zA-Z\d+\-.]*:\S*$`),
+	"uuid": regexp.MustCompile(
+		`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+	),
+	"date-time": regexp.MustCompile(`(?i)^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`),
+	"date":      regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// validate checks a value decoded by encoding/json against the type and returns
+// issues with the problems found appended. The checks and messages mirror the
+// validators generated by the typescript plugin.
+func validate(t processor.Type, value any, path string, issues []Issue) []Issue {
+	if value == nil && processor.GetConstraints(t).Nullable {
+		return issues
+	}
+
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return validateObject(t, value, path, issues)
+	case *processor.TypeEnum:
+		return validateEnum(t, value, path, issues)
+	case *processor.TypeAlias:
+		return validate(t.Alias(), value, path, issues)
+	case *processor.TypeArray:
+		return validateArray(t, value, path, issues)
+	case *processor.TypeMap:
+		if _, ok := value.(map[string]any); !ok {
+			return append(issues, Issue{Path: path, Message: "must be an object"})
+		}
+
+		return issues
+	default:
+		return validateScalar(t, value, path, issues)
+	}
+}
+
+func validateObject(t *processor.TypeObject, value any, path string, issues []Issue) []Issue {
+	record, ok := value.(map[string]any)
+	if !ok {
+		return append(issues, Issue{Path: path, Message: "must be an object"})
+	}
+
+	for _, prop := range t.Properties() {
+		v, ok := record[prop.WireName()]
+		if !ok {
+			if prop.Required() {
+				issues = append(issues, Issue{Path: path + "." + prop.WireName(), Message: "is required"})
+			}
+
+			continue
+		}
+
+		issues = validate(prop.Type, v, path+"."+prop.WireName(), issues)
+	}
+
+	return issues
+}
+
+func validateArray(t *processor.TypeArray, value any, path string, issues []Issue) []Issue {
+	items, ok := value.([]any)
+	if !ok {
+		return append(issues, Issue{Path: path, Message: "must be an array"})
+	}
+
+	c := processor.GetConstraints(t)
+	if c.MinItems != nil && int64(len(items)) < *c.MinItems {
+		issues = append(issues, Issue{
+			Path: path, Message: fmt.Sprintf("must have at least %d items", *c.MinItems),
+		})
+	}
+
+	if c.MaxItems != nil && int64(len(items)) > *c.MaxItems {
+		issues = append(issues, Issue{
+			Path: path, Message: fmt.Sprintf("must have at most %d items", *c.MaxItems),
+		})
+	}
+
+	for i, item := range items {
+		issues = validate(t.Item, item, fmt.Sprintf("%s[%d]", path, i), issues)
+	}
+
+	return issues
+}
+
+// enumValues returns the values allowed by the schema of the enum. Enums referenced
+// from parameters carry no values in the IR so they are read from the schema.
+func enumValues(t *processor.TypeEnum) []any {
+	if t.Schema() == nil || t.Schema().Schema() == nil {
+		return nil
+	}
+
+	values := make([]any, 0, len(t.Schema().Schema().Enum))
+
+	for _, node := range t.Schema().Schema().Enum {
+		var v any
+		if err := node.Decode(&v); err == nil {
+			values = append(values, normalize(v))
+		}
+	}
+
+	return values
+}
+
+func validateEnum(t *processor.TypeEnum, value any, path string, issues []Issue) []Issue {
+	values := enumValues(t)
+	if len(values) == 0 {
+		return validateScalar(t, value, path, issues)
+	}
+
+	for _, v := range values {
+		if v == normalize(value) {
+			return issues
+		}
+	}
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = jsonString(v)
+	}
+
+	return append(issues, Issue{
+		Path: path, Message: "must be one of " + strings.Join(quoted, ", "),
+	})
+}
+
+func validateScalar(t processor.Type, value any, path string, issues []Issue) []Issue {
+	switch processor.ScalarType(t) {
+	case "string":
+		return validateString(t, value, path, issues)
+	case "integer":
+		return validateNumber(t, value, path, true, issues)
+	case "number":
+		return validateNumber(t, value, path, false, issues)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return append(issues, Issue{Path: path, Message: "must be a boolean"})
+		}
+
+		return issues
+	default:
+		return issues
+	}
+}
+
+func validateString(t processor.Type, value any, path string, issues []Issue) []Issue {
+	c := processor.GetConstraints(t)
+
+	// binary values are uploaded as files and never reach the JSON validator
+	if c.Format == "binary" {
+		return issues
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return append(issues, Issue{Path: path, Message: "must be a string"})
+	}
+
+	length := int64(utf8.RuneCountInString(s))
+
+	if c.MinLength != nil && length < *c.MinLength {
+		issues = append(issues, Issue{
+			Path: path, Message: fmt.Sprintf("must be at least %d characters long", *c.MinLength),
+		})
+	}
+
+	if c.MaxLength != nil && length > *c.MaxLength {
+		issues = append(issues, Issue{
+			Path: path, Message: fmt.Sprintf("must be at most %d characters long", *c.MaxLength),
+		})
+	}
+
+	// patterns using ECMAScript features unknown to RE2 are not checked
+	if re, err := regexp.Compile(c.Pattern); c.Pattern != "" && err == nil && !re.MatchString(s) {
+		issues = append(issues, Issue{Path: path, Message: "must match pattern " + c.Pattern})
+	}
+
+	if re, ok := formats[c.Format]; ok && !re.MatchString(s) {
+		issues = append(issues, Issue{Path: path, Message: "must be a valid " + c.Format})
+	}
+
+	return issues
+}
+
+func validateNumber(
+	t processor.Type, value any, path string, integer bool, issues []Issue,
+) []Issue {
+	n, ok := value.(float64)
+	if !ok || math.IsNaN(n) {
+		return append(issues, Issue{Path: path, Message: "must be a number"})
+	}
+
+	c := processor.GetConstraints(t)
+
+	if integer && n != math.Trunc(n) {
+		issues = append(issues, Issue{Path: path, Message: "must be an integer"})
+	}
+
+	if c.Minimum != nil {
+		switch {
+		case c.ExclusiveMinimum && n <= *c.Minimum:
+			issues = append(issues, Issue{
+				Path: path, Message: "must be greater than " + formatNumber(*c.Minimum),
+			})
+		case !c.ExclusiveMinimum && n < *c.Minimum:
+			issues = append(issues, Issue{
+				Path: path, Message: "must be at least " + formatNumber(*c.Minimum),
+			})
+		}
+	}
+
+	if c.Maximum != nil {
+		switch {
+		case c.ExclusiveMaximum && n >= *c.Maximum:
+			issues = append(issues, Issue{
+				Path: path, Message: "must be less than " + formatNumber(*c.Maximum),
+			})
+		case !c.ExclusiveMaximum && n > *c.Maximum:
+			issues = append(issues, Issue{
+				Path: path, Message: "must be at most " + formatNumber(*c.Maximum),
+			})
+		}
+	}
+
+	return issues
+}
+
+// normalize converts the integers decoded from YAML into float64 so they compare
+// equal to the numbers decoded by encoding/json.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	default:
+		return v
+	}
+}
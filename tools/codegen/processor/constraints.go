@@ -0,0 +1,86 @@
+package processor
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// Constraints are the validation keywords of a schema that plugins can use to
+// generate validation code. Exclusive bounds from OpenAPI 3.0 (booleans) and 3.1
+// (numbers) are both normalized into Minimum/Maximum plus the exclusive flag.
+type Constraints struct {
+	Pattern          string
+	Format           string
+	MinLength        *int64
+	MaxLength        *int64
+	Minimum          *float64
+	Maximum          *float64
+	ExclusiveMinimum bool
+	ExclusiveMaximum bool
+	MinItems         *int64
+	MaxItems         *int64
+	Nullable         bool
+}
+
+func (c *Constraints) HasLength() bool {
+	return c.MinLength != nil || c.MaxLength != nil
+}
+
+func (c *Constraints) HasBounds() bool {
+	return c.Minimum != nil || c.Maximum != nil
+}
+
+func (c *Constraints) HasItems() bool {
+	return c.MinItems != nil || c.MaxItems != nil
+}
+
+func exclusiveBound(
+	bound *float64, exclusive *base.DynamicValue[bool, float64],
+) (*float64, bool) {
+	if exclusive == nil {
+		return bound, false
+	}
+
+	if exclusive.IsA() {
+		return bound, exclusive.A
+	}
+
+	v := exclusive.B
+
+	return &v, true
+}
+
+// GetConstraints returns the validation constraints of the type's schema.
+func GetConstraints(t Type) *Constraints {
+	if t == nil || t.Schema() == nil || t.Schema().Schema() == nil {
+		return &Constraints{} //nolint:exhaustruct
+	}
+
+	schema := t.Schema().Schema()
+
+	minimum, exclusiveMinimum := exclusiveBound(schema.Minimum, schema.ExclusiveMinimum)
+	maximum, exclusiveMaximum := exclusiveBound(schema.Maximum, schema.ExclusiveMaximum)
+
+	return &Constraints{
+		Pattern:          schema.Pattern,
+		Format:           schema.Format,
+		MinLength:        schema.MinLength,
+		MaxLength:        schema.MaxLength,
+		Minimum:          minimum,
+		Maximum:          maximum,
+		ExclusiveMinimum: exclusiveMinimum,
+		ExclusiveMaximum: exclusiveMaximum,
+		MinItems:         schema.MinItems,
+		MaxItems:         schema.MaxItems,
+		Nullable:         schema.Nullable != nil && *schema.Nullable,
+	}
+}
+
+// ScalarType returns the JSON type of the type's schema (e.g. string, integer).
+func ScalarType(t Type) string {
+	if t == nil || t.Schema() == nil || t.Schema().Schema() == nil ||
+		len(t.Schema().Schema().Type) == 0 {
+		return ""
+	}
+
+	return t.Schema().Schema().Type[0]
+}
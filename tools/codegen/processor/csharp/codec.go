@@ -0,0 +1,313 @@
+package csharp
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func isBinary(t processor.Type) bool {
+	return processor.ScalarType(t) == "string" && processor.GetConstraints(t).Format == "binary"
+}
+
+//nolint:gochecknoglobals
+var valueTypes = []string{"int", "long", "double", "bool", "JsonElement"}
+
+// isValueType returns true if t is a C# value type, which is nullable only if it
+// is wrapped in Nullable<T>. Parameters referencing components carry no values so
+// their schema tells whether they are enums or scalars.
+func isValueType(t processor.Type) bool {
+	switch t := t.(type) {
+	case *processor.TypeEnum:
+		if len(t.EnumValues()) > 0 || len(t.Schema().Schema().Enum) > 0 {
+			return true
+		}
+
+		return slices.Contains([]string{"integer", "number", "boolean"}, processor.ScalarType(t))
+	case *processor.TypeAlias:
+		return isValueType(t.Alias())
+	default:
+		return slices.Contains(valueTypes, t.Name())
+	}
+}
+
+func isOptional(prop *processor.Property) bool {
+	return !prop.Required() || processor.GetConstraints(prop.Type).Nullable
+}
+
+// csharpMember returns the name of the C# property for prop. Members can't be
+// named after their enclosing type so those get a suffix.
+func csharpMember(prop *processor.Property) string {
+	if prop.Name() == prop.Parent.Name() {
+		return prop.Name() + "Value"
+	}
+
+	return prop.Name()
+}
+
+// csharpFieldType returns the type of the C# property for prop.
+func csharpFieldType(prop *processor.Property) string {
+	if isOptional(prop) {
+		return prop.Type.Name() + "?"
+	}
+
+	return prop.Type.Name()
+}
+
+// csharpFieldInit returns the initializer of the C# property for prop, which
+// non-nullable reference types need to satisfy the nullable analysis.
+func csharpFieldInit(prop *processor.Property) string {
+	if isOptional(prop) || isValueType(prop.Type) {
+		return ""
+	}
+
+	return " = default!;"
+}
+
+// csharpIgnoreNull returns true if prop is omitted from the JSON document when it
+// is null instead of being sent as null.
+func csharpIgnoreNull(prop *processor.Property) bool {
+	return !prop.Required()
+}
+
+//nolint:gochecknoglobals
+var aliasTargets = map[string]string{
+	"string":      "System.String",
+	"int":         "System.Int32",
+	"long":        "System.Int64",
+	"double":      "System.Double",
+	"bool":        "System.Boolean",
+	"byte[]":      "System.Byte[]",
+	"JsonElement": "System.Text.Json.JsonElement",
+}
+
+// csharpAliasTarget returns the fully qualified name of the type aliased by t, as
+// required by using alias directives.
+func csharpAliasTarget(t *processor.TypeAlias) string {
+	if target, ok := aliasTargets[t.Alias().Name()]; ok {
+		return target
+	}
+
+	return t.Alias().Name()
+}
+
+// EnumMember is a member of a generated enum.
+type EnumMember struct {
+	Name string
+	// Value is the C# literal of the JSON value the member stands for
+	Value      string
+	Deprecated bool
+}
+
+// csharpEnumMembers returns the members of the enum with unique PascalCase names
+// derived from their values. Unknown is reserved for the fallback.
+func csharpEnumMembers(t *processor.TypeEnum) []*EnumMember {
+	members := make([]*EnumMember, 0, len(t.EnumValues()))
+
+	for _, v := range t.EnumValues() {
+		name := pascal(fmt.Sprint(v.Raw()))
+
+		switch {
+		case strings.HasPrefix(name, "_"):
+			name = "Value" + strings.TrimPrefix(name, "_")
+		case name == "Unknown" || name == t.Name():
+			name += "Value"
+		}
+
+		unique := name
+		for i := 2; slices.ContainsFunc(members, func(m *EnumMember) bool {
+			return m.Name == unique
+		}); i++ {
+			unique = fmt.Sprintf("%s%d", name, i)
+		}
+
+		members = append(members, &EnumMember{
+			Name:       unique,
+			Value:      v.Value(),
+			Deprecated: v.Deprecated(),
+		})
+	}
+
+	return members
+}
+
+// csharpFormTypes returns the multipart request bodies of the methods. Each of
+// them gets a method converting it into a MultipartFormDataContent.
+func csharpFormTypes(methods []*processor.Method) []*processor.TypeObject {
+	types := make([]*processor.TypeObject, 0)
+
+	for _, m := range methods {
+		t, ok := m.RequestFormData().(*processor.TypeObject)
+		if !ok || slices.ContainsFunc(types, func(o *processor.TypeObject) bool {
+			return o.Name() == t.Name()
+		}) {
+			continue
+		}
+
+		types = append(types, t)
+	}
+
+	return types
+}
+
+// csharpFormField returns the statements that add prop, read from expr, to the
+// multipart `form`, indented with indent spaces. Binary values are sent as files,
+// objects as JSON parts and everything else as text fields.
+func csharpFormField(prop *processor.Property, expr string, indent int) string {
+	key := csharpString(prop.WireName())
+	prefix := strings.Repeat(" ", indent)
+
+	part := func(t processor.Type, value string) string {
+		switch {
+		case isBinary(t):
+			return fmt.Sprintf("Runtime.AddFile(form, %s, %s);", key, value)
+		case t.Kind() == processor.KindIdentifierObject || t.Kind() == processor.KindIdentifierMap:
+			return fmt.Sprintf("Runtime.AddJson(form, %s, %s);", key, value)
+		default:
+			return fmt.Sprintf("Runtime.AddText(form, %s, %s);", key, value)
+		}
+	}
+
+	statement := part(prop.Type, expr)
+	if t, ok := prop.Type.(*processor.TypeArray); ok {
+		statement = fmt.Sprintf(
+			"foreach (var item in %s)\n%s{\n%s    %s\n%s}",
+			expr, prefix, prefix, part(t.Item, "item"), prefix,
+		)
+	}
+
+	if !isOptional(prop) {
+		return statement
+	}
+
+	return fmt.Sprintf(
+		"if (%s != null)\n%s{\n%s    %s\n%s}",
+		expr, prefix, prefix, strings.ReplaceAll(statement, "\n", "\n    "), prefix,
+	)
+}
+
+func responseType(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return ""
+	case r.MediaType == "application/json" && r.Type != nil:
+		return r.Type.Name()
+	case r.MediaType == "application/json":
+		return "JsonElement"
+	default:
+		return "byte[]"
+	}
+}
+
+// csharpReturnType returns the type of the FetchResponse returned by m. Methods
+// returning different types per status code return the raw body.
+func csharpReturnType(m *processor.Method) string {
+	types := make([]string, 0, 4) //nolint:mnd
+	for _, r := range m.SuccessResponses() {
+		if t := responseType(r); !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+
+	switch {
+	case len(types) == 0 || (len(types) == 1 && types[0] == ""):
+		return "FetchResponse"
+	case len(types) == 1:
+		return "FetchResponse<" + types[0] + ">"
+	default:
+		return "FetchResponse<byte[]>"
+	}
+}
+
+// csharpDecodeResponse returns a C# expression building the FetchResponse of r
+// from `data`, `status` and `responseHeaders`.
+func csharpDecodeResponse(m *processor.Method, r *processor.SuccessResponse) string {
+	returnType := csharpReturnType(m)
+
+	switch t := responseType(r); {
+	case returnType == "FetchResponse":
+		return "new FetchResponse(status, responseHeaders)"
+	case returnType == "FetchResponse<byte[]>":
+		return "new FetchResponse<byte[]>(data, status, responseHeaders)"
+	default:
+		return "new " + returnType + "(Runtime.Decode<" + t + ">(data), status, responseHeaders)"
+	}
+}
+
+// errorTypes decodes the bodies of error responses without a schema as JsonElement.
+//
+//nolint:gochecknoglobals
+var errorTypes = processor.ErrorTypes{Fallback: "JsonElement"}
+
+func csharpBodyType(m *processor.Method) string {
+	switch {
+	case m.RequestJSON() != nil:
+		return m.RequestJSON().Name()
+	case m.RequestFormData() != nil:
+		return m.RequestFormData().Name()
+	default:
+		return "byte[]"
+	}
+}
+
+// csharpArguments returns the parameter list of the method, one per line indented
+// with indent spaces. Every method sending a request takes a CancellationToken.
+func csharpArguments(m *processor.Method, indent int) string {
+	args := make([]string, 0, len(m.Parameters)+4) //nolint:mnd
+
+	for _, param := range m.PathParameters() {
+		args = append(args, param.Type.Name()+" "+argument(param.Name()))
+	}
+
+	if m.RequestHasBody() && !m.IsRedirect() {
+		if m.BodyRequired {
+			args = append(args, csharpBodyType(m)+" body")
+		} else {
+			args = append(args, csharpBodyType(m)+"? body = null")
+		}
+	}
+
+	if m.HasQueryParameters() {
+		args = append(args, m.Name()+"Params? parameters = null")
+	}
+
+	if !m.IsRedirect() {
+		args = append(args,
+			"IDictionary<string, string>? headers = null",
+			"CancellationToken cancellationToken = default",
+		)
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+
+	return "\n" + prefix + "    " + strings.Join(args, ",\n"+prefix+"    ")
+}
+
+// csharpQueryParameter returns the statement adding param, read from the
+// `parameters` record, to the `query` list, with continuation lines indented with
+// indent spaces. Parameters with content are sent as JSON strings.
+func csharpQueryParameter(param *processor.Parameter, indent int) string {
+	value := "Runtime.ToJson(parameters." + param.Name() + ")"
+	if param.IsContent() {
+		value = "Runtime.ToJson(JsonSerializer.Serialize(parameters." + param.Name() + ", Runtime.JsonOptions))"
+	}
+
+	prefix := strings.Repeat(" ", indent+4) //nolint:mnd
+	args := []string{
+		"query",
+		csharpString(param.WireName()),
+		value,
+		csharpString(string(param.Style())),
+		strconv.FormatBool(param.Explode()),
+		strconv.FormatBool(param.AllowReserved()),
+	}
+
+	return "Runtime.SerializeQuery(\n" + prefix + strings.Join(args, ",\n"+prefix) + ");"
+}
@@ -0,0 +1,267 @@
+package csharp
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// CSharp generates System.Text.Json records, enums with converters tolerating
+// unknown values and an async client built on HttpClient.
+type CSharp struct {
+	// Namespace of the generated code, the global namespace if empty. Clients
+	// compiled in the same assembly need different namespaces.
+	Namespace string
+}
+
+func (c *CSharp) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (c *CSharp) GetFuncMap() map[string]any {
+	return map[string]any{
+		"csharpNamespace":      func() string { return c.Namespace },
+		"csharpString":         csharpString,
+		"csharpDoc":            csharpDoc,
+		"csharpDeprecated":     csharpDeprecated,
+		"csharpMember":         csharpMember,
+		"csharpFieldType":      csharpFieldType,
+		"csharpFieldInit":      csharpFieldInit,
+		"csharpIsValueType":    isValueType,
+		"csharpIgnoreNull":     csharpIgnoreNull,
+		"csharpAliasTarget":    csharpAliasTarget,
+		"csharpEnumMembers":    csharpEnumMembers,
+		"csharpFormTypes":      csharpFormTypes,
+		"csharpFormField":      csharpFormField,
+		"csharpReturnType":     csharpReturnType,
+		"csharpDecodeResponse": csharpDecodeResponse,
+		"csharpErrorResponses": errorTypes.Responses,
+		"csharpErrorType":      errorTypes.Type,
+		"csharpErrorDefault":   errorTypes.Default,
+		"csharpArguments":      csharpArguments,
+		"csharpQueryParameter": csharpQueryParameter,
+	}
+}
+
+//nolint:gochecknoglobals
+var keywords = []string{
+	"abstract", "as", "base", "bool", "break", "byte", "case", "catch", "char", "checked",
+	"class", "const", "continue", "decimal", "default", "delegate", "do", "double", "else",
+	"enum", "event", "explicit", "extern", "false", "finally", "fixed", "float", "for",
+	"foreach", "goto", "if", "implicit", "in", "int", "interface", "internal", "is", "lock",
+	"long", "namespace", "new", "null", "object", "operator", "out", "override", "params",
+	"private", "protected", "public", "readonly", "ref", "return", "sbyte", "sealed",
+	"short", "sizeof", "stackalloc", "static", "string", "struct", "switch", "this",
+	"throw", "true", "try", "typeof", "uint", "ulong", "unchecked", "unsafe", "ushort",
+	"using", "virtual", "void", "volatile", "while",
+}
+
+// pascal converts name to a PascalCase identifier.
+func pascal(name string) string {
+	name = format.Title(format.ToLowerCamelCase(name))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// argument converts name to a camelCase identifier usable as a method argument,
+// escaping it with @ if it is a keyword.
+func argument(name string) string {
+	name = format.ToLowerCamelCase(name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	if slices.Contains(keywords, name) {
+		return "@" + name
+	}
+
+	return name
+}
+
+func (c *CSharp) TypeObjectName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (c *CSharp) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (c *CSharp) TypeScalarName(scalar *processor.TypeScalar) string {
+	schema := scalar.Schema().Schema()
+
+	switch schema.Type[0] {
+	case "string":
+		if schema.Format == "binary" {
+			return "byte[]"
+		}
+
+		return "string"
+	case "integer":
+		if schema.Format == "int64" {
+			return "long"
+		}
+
+		return "int"
+	case "number":
+		return "double"
+	case "boolean":
+		return "bool"
+	default:
+		return "JsonElement"
+	}
+}
+
+func (c *CSharp) TypeArrayName(array *processor.TypeArray) string {
+	return "List<" + array.Item.Name() + ">"
+}
+
+func (c *CSharp) TypeEnumName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+// TypeEnumValues returns the values as C# literals, which the converters of the
+// enums compare the JSON values with.
+func (c *CSharp) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			enumValues[i] = csharpString(v)
+		case nil:
+			enumValues[i] = "null"
+		default:
+			enumValues[i] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return enumValues
+}
+
+func (c *CSharp) TypeMapName(_ *processor.TypeMap) string {
+	return "Dictionary<string, JsonElement>"
+}
+
+// MethodName returns the PascalCase name of the method. The templates append
+// Async or Url depending on the kind of method.
+func (c *CSharp) MethodName(name string) string {
+	return pascal(name)
+}
+
+// MethodPath returns a C# expression that builds the path of the method from the
+// arguments of the method.
+func (c *CSharp) MethodPath(segments []*processor.PathSegment) string {
+	parts := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		if !segment.IsParameter() {
+			parts = append(parts, csharpString(segment.Literal))
+			continue
+		}
+
+		param := segment.Parameter
+		parts = append(parts, fmt.Sprintf(
+			"Runtime.SerializePath(%s, %s, %s, %t)",
+			csharpString(param.WireName()),
+			argument(param.Name()),
+			csharpString(string(param.Style())),
+			param.Explode(),
+		))
+	}
+
+	if len(parts) == 0 {
+		return `""`
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+// ParameterName returns the PascalCase name of the property of the parameter in
+// the Params record. Path parameters are passed as camelCase arguments instead.
+func (c *CSharp) ParameterName(name string) string {
+	return pascal(name)
+}
+
+func (c *CSharp) PropertyName(name string) string {
+	return pascal(name)
+}
+
+func (c *CSharp) BinaryType() string {
+	return "byte[]"
+}
+
+// csharpString returns s as a C# string literal.
+//
+//nolint:gochecknoglobals
+var csharpString = processor.StringLiteral{Interpolation: "", BracedUnicode: false, SingleQuoted: false}.Quote
+
+// csharpDoc returns an XML documentation comment indented with indent spaces
+// built from the non-empty parts, or an empty string if there is nothing to
+// document. The first part is the summary and the rest go into remarks.
+func csharpDoc(indent int, parts ...string) string {
+	paragraphs := make([]string, 0, len(parts))
+	escape := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			paragraphs = append(paragraphs, escape.Replace(part))
+		}
+	}
+
+	if len(paragraphs) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent) + "///"
+	lines := make([]string, 0, 8) //nolint:mnd
+
+	element := func(tag string, text string) {
+		if !strings.Contains(text, "\n") {
+			lines = append(lines, prefix+" <"+tag+">"+text+"</"+tag+">")
+			return
+		}
+
+		lines = append(lines, prefix+" <"+tag+">")
+		for _, line := range strings.Split(text, "\n") {
+			if line == "" {
+				lines = append(lines, prefix)
+			} else {
+				lines = append(lines, prefix+" "+line)
+			}
+		}
+
+		lines = append(lines, prefix+" </"+tag+">")
+	}
+
+	element("summary", paragraphs[0])
+
+	if len(paragraphs) > 1 {
+		element("remarks", strings.Join(paragraphs[1:], "\n\n"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// csharpDeprecated returns the Obsolete attribute for an element, or an empty
+// string if it isn't deprecated.
+func csharpDeprecated(deprecated bool, message string) string {
+	switch {
+	case !deprecated:
+		return ""
+	case message == "":
+		return "[Obsolete]"
+	default:
+		return "[Obsolete(" + csharpString(message) + ")]"
+	}
+}
@@ -0,0 +1,349 @@
+package dart
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func isBinary(t processor.Type) bool {
+	return processor.ScalarType(t) == "string" && processor.GetConstraints(t).Format == "binary"
+}
+
+func isNullable(t processor.Type) bool {
+	return processor.GetConstraints(t).Nullable
+}
+
+// isEnum returns true if t is generated as a Dart enum class. Referenced
+// parameters are represented as enums in the IR even if their schema is a plain
+// scalar.
+func isEnum(t processor.Type) bool {
+	_, ok := t.(*processor.TypeEnum)
+	return ok && t.Schema() != nil && t.Schema().Schema() != nil &&
+		len(t.Schema().Schema().Enum) > 0
+}
+
+func decodeScalar(t processor.Type, expr string) string {
+	switch processor.ScalarType(t) {
+	case "string":
+		if isBinary(t) {
+			return "base64Decode(" + expr + " as String)"
+		}
+
+		return expr + " as String"
+	case "integer":
+		return "(" + expr + " as num).toInt()"
+	case "number":
+		return "(" + expr + " as num).toDouble()"
+	case "boolean":
+		return expr + " as bool"
+	default:
+		return expr + " as Object"
+	}
+}
+
+func decode(t processor.Type, expr string, depth int) string {
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return t.Name() + ".fromJson(" + expr + " as Map<String, dynamic>)"
+	case *processor.TypeEnum:
+		if isEnum(t) {
+			return t.Name() + ".fromJson(" + expr + ")"
+		}
+
+		return decodeScalar(t, expr)
+	case *processor.TypeAlias:
+		return decode(t.Alias(), expr, depth)
+	case *processor.TypeArray:
+		e := fmt.Sprintf("e%d", depth)
+
+		return fmt.Sprintf(
+			"(%s as List<dynamic>).map((%s) => %s).toList()",
+			expr, e, decode(t.Item, e, depth+1),
+		)
+	case *processor.TypeMap:
+		return expr + " as Map<String, dynamic>"
+	default:
+		return decodeScalar(t, expr)
+	}
+}
+
+func encode(t processor.Type, expr string, depth int) string {
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return expr + ".toJson()"
+	case *processor.TypeEnum:
+		if isEnum(t) {
+			return expr + ".toJson()"
+		}
+
+		return expr
+	case *processor.TypeAlias:
+		return encode(t.Alias(), expr, depth)
+	case *processor.TypeArray:
+		e := fmt.Sprintf("e%d", depth)
+
+		item := encode(t.Item, e, depth+1)
+		if item == e {
+			return expr
+		}
+
+		return fmt.Sprintf("%s.map((%s) => %s).toList()", expr, e, item)
+	default:
+		if isBinary(t) {
+			return "base64Encode(" + expr + ")"
+		}
+
+		return expr
+	}
+}
+
+// dartEncode returns a Dart expression converting expr into JSON compatible values.
+func dartEncode(t processor.Type, expr string) string {
+	return encode(t, expr, 0)
+}
+
+// dartFieldType returns the type of the field for prop.
+func dartFieldType(prop *processor.Property) string {
+	if !prop.Required() || isNullable(prop.Type) {
+		return prop.Type.Name() + "?"
+	}
+
+	return prop.Type.Name()
+}
+
+// dartDecodeProperty returns a Dart expression reading prop from the map `json`.
+func dartDecodeProperty(prop *processor.Property) string {
+	value := "json[" + dartString(prop.WireName()) + "]"
+
+	if prop.Required() && !isNullable(prop.Type) {
+		return decode(prop.Type, value, 0)
+	}
+
+	return fmt.Sprintf("%s == null ? null : %s", value, decode(prop.Type, value, 0))
+}
+
+// dartEncodeProperty returns the map entry of prop in the toJson method.
+func dartEncodeProperty(prop *processor.Property) string {
+	key := dartString(prop.WireName())
+	name := prop.Name()
+
+	switch {
+	case prop.Required() && !isNullable(prop.Type):
+		return fmt.Sprintf("%s: %s,", key, dartEncode(prop.Type, name))
+	case prop.Required():
+		if encoded := dartEncode(prop.Type, name+"!"); encoded != name+"!" {
+			return fmt.Sprintf("%s: %s == null ? null : %s,", key, name, encoded)
+		}
+
+		return fmt.Sprintf("%s: %s,", key, name)
+	default:
+		return fmt.Sprintf("if (%s != null) %s: %s,", name, key, dartEncode(prop.Type, name+"!"))
+	}
+}
+
+// EnumMember is a constant of a generated Dart enum class.
+type EnumMember struct {
+	Name       string
+	Value      string
+	Deprecated bool
+}
+
+//nolint:gochecknoglobals
+var enumReserved = []string{
+	"value", "values", "isUnknown", "fromJson", "toJson", "hashCode", "toString",
+	"runtimeType", "noSuchMethod",
+}
+
+// dartEnumMembers returns the members of the enum with unique identifiers derived
+// from their values.
+func dartEnumMembers(t *processor.TypeEnum) []*EnumMember {
+	members := make([]*EnumMember, 0, len(t.EnumValues()))
+
+	for _, v := range t.EnumValues() {
+		name := format.ToLowerCamelCase(fmt.Sprint(v.Raw()))
+
+		switch {
+		case name == "":
+			name = "empty"
+		case name[0] >= '0' && name[0] <= '9':
+			name = "value" + name
+		case slices.Contains(keywords, name) || slices.Contains(enumReserved, name):
+			name += "_"
+		}
+
+		unique := name
+		for i := 2; slices.ContainsFunc(members, func(m *EnumMember) bool {
+			return m.Name == unique
+		}); i++ {
+			unique = fmt.Sprintf("%s%d", name, i)
+		}
+
+		members = append(members, &EnumMember{
+			Name:       unique,
+			Value:      v.Value(),
+			Deprecated: v.Deprecated(),
+		})
+	}
+
+	return members
+}
+
+// dartFormField returns the statement that adds prop, read from expr, to the
+// files of the multipart `request`. Binary values are sent as files, objects as
+// JSON parts and everything else as text fields.
+func dartFormField(prop *processor.Property, expr string) string {
+	key := dartString(prop.WireName())
+
+	part := func(t processor.Type, value string) string {
+		switch {
+		case isBinary(t):
+			return fmt.Sprintf("_fileField(%s, %s)", key, value)
+		case t.Kind() == processor.KindIdentifierObject || t.Kind() == processor.KindIdentifierMap:
+			return fmt.Sprintf("_jsonField(%s, %s)", key, dartEncode(t, value))
+		default:
+			return fmt.Sprintf("_textField(%s, %s)", key, dartEncode(t, value))
+		}
+	}
+
+	if t, ok := prop.Type.(*processor.TypeArray); ok {
+		return fmt.Sprintf("request.files.addAll(%s.map((v) => %s));", expr, part(t.Item, "v"))
+	}
+
+	return fmt.Sprintf("request.files.add(%s);", part(prop.Type, expr))
+}
+
+func responseType(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return "void"
+	case r.MediaType == "application/json" && r.Type != nil:
+		return r.Type.Name()
+	case r.MediaType == "application/json":
+		return "dynamic"
+	default:
+		return "Uint8List"
+	}
+}
+
+// dartReturnType returns the type of the body of the FetchResponse returned by m.
+func dartReturnType(m *processor.Method) string {
+	types := make([]string, 0, 4) //nolint:mnd
+	for _, r := range m.SuccessResponses() {
+		if t := responseType(r); !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+
+	switch len(types) {
+	case 0:
+		return "void"
+	case 1:
+		return types[0]
+	default:
+		return "Object?"
+	}
+}
+
+// dartDecodeResponse returns a Dart expression reading the body of r from `res`.
+func dartDecodeResponse(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return "null"
+	case r.MediaType == "application/json" && r.Type != nil:
+		return decode(r.Type, "_json(res)", 0)
+	case r.MediaType == "application/json":
+		return "_json(res)"
+	default:
+		return "res.bodyBytes"
+	}
+}
+
+func dartBodyType(m *processor.Method) string {
+	switch {
+	case m.RequestJSON() != nil:
+		return m.RequestJSON().Name()
+	case m.RequestFormData() != nil:
+		return m.RequestFormData().Name()
+	default:
+		return "Uint8List"
+	}
+}
+
+// dartArguments returns the parameter list of the method, formatted the way
+// dart format does: path parameters and a required body are positional, an
+// optional body, the query parameters and the extra headers are named.
+func dartArguments(m *processor.Method, indent int) string {
+	positional := make([]string, 0, len(m.Parameters)+1)
+	named := make([]string, 0, 3) //nolint:mnd
+
+	for _, param := range m.PathParameters() {
+		positional = append(positional, param.Type.Name()+" "+param.Name())
+	}
+
+	if m.RequestHasBody() && !m.IsRedirect() {
+		if m.BodyRequired {
+			positional = append(positional, dartBodyType(m)+" body")
+		} else {
+			named = append(named, dartBodyType(m)+"? body")
+		}
+	}
+
+	if m.HasQueryParameters() {
+		named = append(named, format.Title(m.Name())+"Params? params")
+	}
+
+	if !m.IsRedirect() {
+		named = append(named, "Map<String, String>? headers")
+	}
+
+	if len(positional) == 0 && len(named) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+	inner := prefix + "  "
+
+	var b strings.Builder
+
+	if len(positional) == 0 {
+		b.WriteString("{")
+	}
+
+	b.WriteString("\n")
+
+	for i, p := range positional {
+		b.WriteString(inner + p + ",")
+
+		if i == len(positional)-1 && len(named) > 0 {
+			b.WriteString(" {")
+		}
+
+		b.WriteString("\n")
+	}
+
+	for _, n := range named {
+		b.WriteString(inner + n + ",\n")
+	}
+
+	b.WriteString(prefix)
+
+	if len(named) > 0 {
+		b.WriteString("}")
+	}
+
+	return b.String()
+}
+
+// indented is a method rendered with its statements prefixed by Prefix.
+type indented struct {
+	Method *processor.Method
+	Prefix string
+}
+
+func dartIndent(m *processor.Method, indent int) *indented {
+	return &indented{Method: m, Prefix: strings.Repeat(" ", indent)}
+}
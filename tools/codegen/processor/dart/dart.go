@@ -0,0 +1,215 @@
+package dart
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// Dart generates immutable model classes, enum classes keeping values unknown to
+// the client and a client built on package:http with the same surface as the
+// TypeScript client.
+type Dart struct{}
+
+func (d *Dart) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (d *Dart) GetFuncMap() map[string]any {
+	return map[string]any{
+		"dartString":         dartString,
+		"dartDoc":            dartDoc,
+		"dartDeprecated":     dartDeprecated,
+		"dartFieldType":      dartFieldType,
+		"dartDecodeProperty": dartDecodeProperty,
+		"dartEncodeProperty": dartEncodeProperty,
+		"dartEncode":         dartEncode,
+		"dartEnumMembers":    dartEnumMembers,
+		"dartFormField":      dartFormField,
+		"dartReturnType":     dartReturnType,
+		"dartDecodeResponse": dartDecodeResponse,
+		"dartArguments":      dartArguments,
+		"dartBodyType":       dartBodyType,
+		"dartIndent":         dartIndent,
+	}
+}
+
+//nolint:gochecknoglobals
+var keywords = []string{
+	"abstract", "as", "assert", "async", "await", "base", "break", "case", "catch",
+	"class", "const", "continue", "covariant", "default", "deferred", "do", "dynamic",
+	"else", "enum", "export", "extends", "extension", "external", "factory", "false",
+	"final", "finally", "for", "function", "get", "hide", "if", "implements", "import",
+	"in", "interface", "is", "late", "library", "mixin", "new", "null", "of", "on",
+	"operator", "part", "required", "rethrow", "return", "sealed", "set", "show",
+	"static", "super", "switch", "sync", "this", "throw", "true", "try", "type",
+	"typedef", "var", "void", "when", "while", "with", "yield",
+}
+
+func identifier(name string) string {
+	name = format.ToLowerCamelCase(name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "$" + name
+	}
+
+	if slices.Contains(keywords, name) {
+		return name + "_"
+	}
+
+	return name
+}
+
+func (d *Dart) TypeObjectName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (d *Dart) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (d *Dart) TypeScalarName(scalar *processor.TypeScalar) string {
+	switch scalar.Schema().Schema().Type[0] {
+	case "string":
+		if scalar.Schema().Schema().Format == "binary" {
+			return "Uint8List"
+		}
+
+		return "String"
+	case "integer":
+		return "int"
+	case "number":
+		return "double"
+	case "boolean":
+		return "bool"
+	default:
+		return "Object"
+	}
+}
+
+func (d *Dart) TypeArrayName(array *processor.TypeArray) string {
+	return "List<" + array.Item.Name() + ">"
+}
+
+func (d *Dart) TypeEnumName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (d *Dart) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			enumValues[i] = dartString(v)
+		case nil:
+			enumValues[i] = "null"
+		default:
+			enumValues[i] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return enumValues
+}
+
+func (d *Dart) TypeMapName(_ *processor.TypeMap) string {
+	return "Map<String, dynamic>"
+}
+
+func (d *Dart) MethodName(name string) string {
+	return identifier(name)
+}
+
+// MethodPath returns a Dart expression that builds the path of the method.
+func (d *Dart) MethodPath(segments []*processor.PathSegment) string {
+	parts := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		if !segment.IsParameter() {
+			parts = append(parts, dartString(segment.Literal))
+			continue
+		}
+
+		param := segment.Parameter
+		parts = append(parts, fmt.Sprintf(
+			"_serializePath(%s, %s, %s, %t)",
+			dartString(param.WireName()),
+			dartEncode(param.Type, param.Name()),
+			dartString(string(param.Style())),
+			param.Explode(),
+		))
+	}
+
+	if len(parts) == 0 {
+		return "''"
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+func (d *Dart) ParameterName(name string) string {
+	return identifier(name)
+}
+
+func (d *Dart) PropertyName(name string) string {
+	return identifier(name)
+}
+
+func (d *Dart) BinaryType() string {
+	return "Uint8List"
+}
+
+// dartString returns s as a Dart string literal.
+//
+//nolint:gochecknoglobals
+var dartString = processor.StringLiteral{Interpolation: "$", BracedUnicode: false, SingleQuoted: true}.Quote
+
+// dartDoc returns a documentation comment indented with indent spaces built from
+// the non-empty parts, or an empty string if there is nothing to document.
+func dartDoc(indent int, parts ...string) string {
+	paragraphs := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			paragraphs = append(paragraphs, part)
+		}
+	}
+
+	if len(paragraphs) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+	lines := strings.Split(strings.Join(paragraphs, "\n\n"), "\n")
+
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = prefix + "///"
+		} else {
+			lines[i] = prefix + "/// " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// dartDeprecated returns the @Deprecated annotation for an element, or an empty
+// string if it isn't deprecated.
+func dartDeprecated(deprecated bool, message string) string {
+	if !deprecated {
+		return ""
+	}
+
+	if message == "" {
+		message = "deprecated"
+	}
+
+	return "@Deprecated(" + dartString(message) + ")"
+}
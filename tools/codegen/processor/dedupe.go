@@ -0,0 +1,89 @@
+package processor
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+/*
+Types are named after the schema or the property they come from, so the same name
+can be derived more than once, e.g. a property `settings` of `User` and a schema
+`UserSettings`, or the same inline schema used by several operations. Before
+rendering, types are deduplicated by name as the plugin renders it:
+
+- Types with the same name and structure (see signature) are declared once.
+- Types with the same name and a different structure are collisions. They fail the
+  generation unless Options.RenameCollisions is set, in which case every type after
+  the first one is renamed by appending a number to its name, e.g. UserSettings2.
+*/
+
+// declared is a type kept by dedupe.
+type declared struct {
+	signature string
+	// name of the type in the document, i.e. before the plugin formats it
+	name string
+}
+
+func setTypeName(t Type, name string) {
+	switch t := t.(type) {
+	case *TypeObject:
+		t.name = name
+	case *TypeEnum:
+		t.name = name
+	case *TypeAlias:
+		t.name = name
+	}
+}
+
+func dedupe(types []Type, renameCollisions bool) ([]Type, error) {
+	seen := make(map[string][]declared)
+	result := make([]Type, 0, len(types))
+
+	for _, t := range types {
+		name, ok := typeName(t)
+		if !ok {
+			result = append(result, t)
+			continue
+		}
+
+		sig := signature(t)
+
+		previous, ok := seen[t.Name()]
+		if !ok {
+			seen[t.Name()] = []declared{{signature: sig, name: name}}
+			result = append(result, t)
+
+			continue
+		}
+
+		if i := slices.IndexFunc(previous, func(d declared) bool {
+			return d.signature == sig
+		}); i >= 0 {
+			// same structure as a type already declared, possibly renamed
+			setTypeName(t, previous[i].name)
+			continue
+		}
+
+		if !renameCollisions {
+			return nil, fmt.Errorf(
+				"%w: %s is derived from schemas with different structures", ErrTypeCollision, t.Name(),
+			)
+		}
+
+		rendered := t.Name()
+
+		renamed := name
+		for i := 2; seen[t.Name()] != nil; i++ {
+			renamed = name + strconv.Itoa(i)
+			setTypeName(t, renamed)
+		}
+
+		// later types with this structure get the same name
+		seen[rendered] = append(seen[rendered], declared{signature: sig, name: renamed})
+		seen[t.Name()] = []declared{{signature: sig, name: renamed}}
+		result = append(result, t)
+	}
+
+	return result, nil
+}
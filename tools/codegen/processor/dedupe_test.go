@@ -0,0 +1,58 @@
+package processor_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupeCollisions(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/collisions.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	_, err = processor.NewInterMediateRepresentation(doc, &typescript.Typescript{}) //nolint:exhaustruct
+	if !errors.Is(err, processor.ErrTypeCollision) {
+		t.Errorf("expected ErrTypeCollision, got %v", err)
+	}
+}
+
+func TestDedupeRenameCollisions(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/collisions.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentationWithOptions(
+		doc, &typescript.Typescript{}, processor.Options{RenameCollisions: true}, //nolint:exhaustruct
+	)
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	assert.Equal(t, []string{
+		"UserSettingsTheme", "UserSettings", "UserSettings2", "UserStatus", "User", "PetOwner", "Pet",
+	}, typeNames(ir.Types))
+
+	buf := bytes.NewBuffer(nil)
+	if err := ir.Render(buf); err != nil {
+		t.Fatalf("failed to render intermediate representation: %v", err)
+	}
+
+	b, err := os.ReadFile("testdata/collisions.yaml.ts")
+	if err != nil {
+		t.Fatalf("failed to read expected output file: %v", err)
+	}
+
+	assert.Equal(t, string(b), buf.String())
+}
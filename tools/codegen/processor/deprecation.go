@@ -0,0 +1,194 @@
+package processor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// extDeprecatedReason explains why something is deprecated and what to use instead
+	extDeprecatedReason = "x-deprecated-reason"
+	// extSunset is the date after which something deprecated will be removed
+	extSunset = "x-sunset"
+	// extEnumDeprecated lists the values of an enum that are deprecated
+	extEnumDeprecated = "x-enum-deprecated"
+)
+
+type DeprecationKind string
+
+const (
+	DeprecationKindMethod    DeprecationKind = "method"
+	DeprecationKindParameter DeprecationKind = "parameter"
+	DeprecationKindType      DeprecationKind = "type"
+	DeprecationKindProperty  DeprecationKind = "property"
+	DeprecationKindEnumValue DeprecationKind = "enum value"
+)
+
+// Deprecation describes a deprecated element of the generated code.
+type Deprecation struct {
+	Kind DeprecationKind
+	// Name of the element, prefixed with the name of its parent if any
+	Name    string
+	Message string
+}
+
+func extensionString(extensions *orderedmap.Map[string, *yaml.Node], name string) string {
+	if extensions == nil {
+		return ""
+	}
+
+	v, ok := extensions.Get(name)
+	if !ok || v == nil {
+		return ""
+	}
+
+	return v.Value
+}
+
+// deprecationMessage builds the deprecation message from the x-deprecated-reason
+// and x-sunset extensions. It returns an empty string if none are present.
+func deprecationMessage(extensions *orderedmap.Map[string, *yaml.Node]) string {
+	parts := make([]string, 0, 2) //nolint:mnd
+
+	if reason := extensionString(extensions, extDeprecatedReason); reason != "" {
+		parts = append(parts, strings.TrimSpace(reason))
+	}
+
+	if sunset := extensionString(extensions, extSunset); sunset != "" {
+		parts = append(parts, "Sunset: "+sunset)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func schemaDeprecated(schema *base.SchemaProxy) bool {
+	if schema == nil || schema.Schema() == nil {
+		return false
+	}
+
+	return schema.Schema().Deprecated != nil && *schema.Schema().Deprecated
+}
+
+func schemaDeprecationMessage(schema *base.SchemaProxy) string {
+	if schema == nil || schema.Schema() == nil {
+		return ""
+	}
+
+	return deprecationMessage(schema.Schema().Extensions)
+}
+
+type EnumValue struct {
+	value  any
+	parent *TypeEnum
+}
+
+// Value returns the value formatted by the plugin.
+func (v *EnumValue) Value() string {
+	return v.parent.p.TypeEnumValues([]any{v.value})[0]
+}
+
+// Raw returns the value as decoded from the schema.
+func (v *EnumValue) Raw() any {
+	return v.value
+}
+
+// Deprecated returns true if the value is listed in the x-enum-deprecated extension.
+func (v *EnumValue) Deprecated() bool {
+	schema := v.parent.Schema()
+	if schema == nil || schema.Schema() == nil || schema.Schema().Extensions == nil {
+		return false
+	}
+
+	node, ok := schema.Schema().Extensions.Get(extEnumDeprecated)
+	if !ok || node == nil {
+		return false
+	}
+
+	var deprecated []any
+	if err := node.Decode(&deprecated); err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(deprecated, func(d any) bool {
+		return fmt.Sprint(d) == fmt.Sprint(v.value)
+	})
+}
+
+// Deprecations returns all the deprecated elements present in the intermediate representation.
+func (ir *InterMediateRepresentation) Deprecations() []Deprecation {
+	deprecations := make([]Deprecation, 0, 10) //nolint:mnd
+
+	for _, m := range ir.Methods {
+		if m.Deprecated() {
+			deprecations = append(deprecations, Deprecation{
+				Kind:    DeprecationKindMethod,
+				Name:    m.Name(),
+				Message: m.DeprecationMessage(),
+			})
+		}
+
+		for _, param := range m.Parameters {
+			if param.Deprecated() {
+				deprecations = append(deprecations, Deprecation{
+					Kind:    DeprecationKindParameter,
+					Name:    m.Name() + "." + param.Name(),
+					Message: param.DeprecationMessage(),
+				})
+			}
+		}
+	}
+
+	for _, t := range ir.Types {
+		deprecations = append(deprecations, typeDeprecations(t)...)
+	}
+
+	return deprecations
+}
+
+// typeDeprecations returns the deprecations of t and its members. Enums declared
+// inline are already reported by the property or parameter declaring them, so
+// only their values are.
+func typeDeprecations(t Type) []Deprecation {
+	deprecations := make([]Deprecation, 0)
+
+	enum, ok := t.(*TypeEnum)
+	inline := ok && !enum.component
+
+	if !inline && schemaDeprecated(t.Schema()) {
+		deprecations = append(deprecations, Deprecation{
+			Kind:    DeprecationKindType,
+			Name:    t.Name(),
+			Message: schemaDeprecationMessage(t.Schema()),
+		})
+	}
+
+	switch t := t.(type) {
+	case *TypeObject:
+		for _, prop := range t.Properties() {
+			if prop.Deprecated() {
+				deprecations = append(deprecations, Deprecation{
+					Kind:    DeprecationKindProperty,
+					Name:    t.Name() + "." + prop.Name(),
+					Message: prop.DeprecationMessage(),
+				})
+			}
+		}
+	case *TypeEnum:
+		for _, v := range t.EnumValues() {
+			if v.Deprecated() {
+				deprecations = append(deprecations, Deprecation{
+					Kind:    DeprecationKindEnumValue,
+					Name:    t.Name() + "." + v.Value(),
+					Message: "",
+				})
+			}
+		}
+	}
+
+	return deprecations
+}
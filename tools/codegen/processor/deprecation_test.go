@@ -0,0 +1,64 @@
+package processor_test
+
+import (
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecations(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/deprecated.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	want := []processor.Deprecation{
+		{
+			Kind:    processor.DeprecationKindMethod,
+			Name:    "signInWebauthn",
+			Message: "Use signInPasskey instead. Sunset: 2026-01-01",
+		},
+		{
+			Kind:    processor.DeprecationKindParameter,
+			Name:    "signInWebauthn.connection",
+			Message: "",
+		},
+		{
+			Kind:    processor.DeprecationKindEnumValue,
+			Name:    "Role.\"anonymous\"",
+			Message: "",
+		},
+		{
+			Kind:    processor.DeprecationKindType,
+			Name:    "LegacyId",
+			Message: "",
+		},
+		{
+			Kind:    processor.DeprecationKindType,
+			Name:    "LegacySession",
+			Message: "Sessions are now returned by the Session schema.",
+		},
+		{
+			Kind:    processor.DeprecationKindProperty,
+			Name:    "SignInWebauthnRequest.email",
+			Message: "Sunset: 2025-12-01",
+		},
+		// the inline enum isn't reported as a type
+		{
+			Kind:    processor.DeprecationKindProperty,
+			Name:    "SignInWebauthnRequest.attachment",
+			Message: "",
+		},
+	}
+
+	assert.Equal(t, want, ir.Deprecations())
+}
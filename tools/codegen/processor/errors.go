@@ -6,4 +6,6 @@ var (
 	ErrRequiredOptionMissing = errors.New("required option missing")
 	ErrUnknownType           = errors.New("unknown type")
 	ErrUnsupportedFeature    = errors.New("unsupported feature")
+	ErrInvalidPath           = errors.New("invalid path")
+	ErrTypeCollision         = errors.New("type collision")
 )
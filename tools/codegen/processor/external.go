@@ -0,0 +1,374 @@
+package processor
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+const (
+	componentSchemasRef    = "#/components/schemas/"
+	componentParametersRef = "#/components/parameters/"
+)
+
+// dereference returns a proxy to the schema the proxy references so types built
+// from it are defined instead of referenced.
+func dereference(proxy *base.SchemaProxy) *base.SchemaProxy {
+	if !proxy.IsReference() {
+		return proxy
+	}
+
+	return base.CreateSchemaProxy(proxy.Schema())
+}
+
+// externalTypes collects the types defined in other files than the root document,
+// e.g. ./common.yaml#/components/schemas/ErrorResponse. As they aren't part of the
+// components of the document they wouldn't be generated otherwise.
+//
+// Several files can declare schemas with the same name, e.g. a User component and
+// ./other.yaml#/User, so external schemas are identified by their location and
+// renamed like the bundler does if their name is taken, e.g. to User2.
+type externalTypes struct {
+	root   string
+	plugin Plugin
+	// names of the types declared for the external schemas and parameters by
+	// refKey and parameterKey
+	names map[string]string
+	// taken names of the components and the external types
+	taken map[string]bool
+	types []Type
+}
+
+// location returns the absolute location of the file of the index.
+func location(idx *index.SpecIndex) string {
+	if idx == nil {
+		return ""
+	}
+
+	return idx.GetSpecAbsolutePath()
+}
+
+// refKey identifies the schema a reference points to by the absolute location of
+// its file and its JSON pointer, e.g. /api/common.yaml#/components/schemas/Page.
+func refKey(proxy *base.SchemaProxy) string {
+	_, pointer, _ := strings.Cut(proxy.GetReference(), "#")
+
+	var idx *index.SpecIndex
+	if proxy.GoLow() != nil {
+		idx = proxy.GoLow().GetIndex()
+	}
+
+	return location(idx) + "#" + pointer
+}
+
+// parameterKey is refKey for referenced parameters.
+func parameterKey(param *v3.Parameter) string {
+	_, pointer, _ := strings.Cut(param.GoLow().GetReference(), "#")
+
+	return location(param.GoLow().GetIndex()) + "#" + pointer
+}
+
+// componentKey is refKey for the components of the root document, which are
+// indexed with the root document even when they reference another file.
+func (e *externalTypes) componentKey(proxy *base.SchemaProxy) string {
+	file, pointer, _ := strings.Cut(proxy.GetReference(), "#")
+	if file == "" {
+		return e.root + "#" + pointer
+	}
+
+	return filepath.Join(filepath.Dir(e.root), filepath.FromSlash(file)) + "#" + pointer
+}
+
+// uniqueName returns name, or name followed by a number if it's taken, and takes
+// it.
+func (e *externalTypes) uniqueName(name string) string {
+	unique := name
+	for i := 2; e.taken[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+
+	e.taken[unique] = true
+
+	return unique
+}
+
+// isExternal returns true if the reference points outside the components of the
+// root document. Refs like #/components/schemas/Page are external when they are
+// found in other files as they are relative to that file.
+func (e *externalTypes) isExternal(proxy *base.SchemaProxy) bool {
+	if !strings.HasPrefix(proxy.GetReference(), componentSchemasRef) {
+		return true
+	}
+
+	origin := proxy.GetReferenceOrigin()
+
+	return e.root != "" && origin != nil && origin.AbsoluteLocation != "" && origin.AbsoluteLocation != e.root
+}
+
+func (e *externalTypes) define(key, name string, proxy *base.SchemaProxy) error {
+	name = e.uniqueName(name)
+	e.names[key] = name
+
+	if proxy.Schema() == nil || len(proxy.Schema().Type) == 0 {
+		return fmt.Errorf("%w: external schema %s has no type", ErrUnknownType, name)
+	}
+
+	_, tt, err := GetType(dereference(proxy), name, e.plugin, true)
+	if err != nil {
+		return fmt.Errorf("failed to create type %s: %w", name, err)
+	}
+
+	e.types = append(e.types, tt...)
+
+	return nil
+}
+
+// schema defines the types of the external schemas referenced by the schema or
+// any of the schemas nested in it.
+func (e *externalTypes) schema(proxy *base.SchemaProxy) error {
+	if proxy == nil {
+		return nil
+	}
+
+	if proxy.IsReference() {
+		if !e.isExternal(proxy) {
+			return nil
+		}
+
+		key := refKey(proxy)
+		if _, ok := e.names[key]; ok {
+			return nil
+		}
+
+		if err := e.define(key, format.GetNameFromComponentRef(proxy.GetReference()), proxy); err != nil {
+			return err
+		}
+	}
+
+	return e.nested(proxy)
+}
+
+// nested defines the types of the external schemas referenced by the schemas
+// nested in the schema.
+func (e *externalTypes) nested(proxy *base.SchemaProxy) error {
+	s := proxy.Schema()
+	if s == nil {
+		return nil
+	}
+
+	nested := slices.Concat(s.AllOf, s.OneOf, s.AnyOf)
+
+	if s.Properties != nil {
+		for propPair := s.Properties.First(); propPair != nil; propPair = propPair.Next() {
+			nested = append(nested, propPair.Value())
+		}
+	}
+
+	if s.Items != nil && s.Items.IsA() {
+		nested = append(nested, s.Items.A)
+	}
+
+	if s.AdditionalProperties != nil && s.AdditionalProperties.IsA() {
+		nested = append(nested, s.AdditionalProperties.A)
+	}
+
+	for _, n := range nested {
+		if err := e.schema(n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *externalTypes) content(content *orderedmap.Map[string, *v3.MediaType]) error {
+	for mediaPair := content.First(); mediaPair != nil; mediaPair = mediaPair.Next() {
+		if err := e.schema(mediaPair.Value().Schema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *externalTypes) parameter(param *v3.Parameter) error {
+	ref := param.GoLow().GetReference()
+	if param.GoLow().IsReference() && !strings.HasPrefix(ref, componentParametersRef) {
+		key := parameterKey(param)
+		if _, ok := e.names[key]; !ok {
+			if err := e.define(key, format.GetNameFromComponentRef(ref), param.Schema); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := e.schema(param.Schema); err != nil {
+		return err
+	}
+
+	return e.content(param.Content)
+}
+
+func (e *externalTypes) operation(shared []*v3.Parameter, op *v3.Operation) error {
+	for _, param := range operationParameters(shared, op) {
+		if err := e.parameter(param); err != nil {
+			return err
+		}
+	}
+
+	if op.RequestBody != nil {
+		if err := e.content(op.RequestBody.Content); err != nil {
+			return err
+		}
+	}
+
+	if op.Responses == nil {
+		return nil
+	}
+
+	responses := make([]*v3.Response, 0, op.Responses.Codes.Len()+1)
+	for codePair := op.Responses.Codes.First(); codePair != nil; codePair = codePair.Next() {
+		responses = append(responses, codePair.Value())
+	}
+
+	if op.Responses.Default != nil {
+		responses = append(responses, op.Responses.Default)
+	}
+
+	for _, response := range responses {
+		if err := e.content(response.Content); err != nil {
+			return err
+		}
+
+		for headerPair := response.Headers.First(); headerPair != nil; headerPair = headerPair.Next() {
+			if err := e.schema(headerPair.Value().Schema); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// renameReferences names the references to external schemas and parameters after
+// the types declared for them, which differ from the names derived from the
+// references if they were renamed or are declared by a component, e.g.
+// Account: {$ref: ./other.yaml#/User}.
+func renameReferences(types []Type, methods []*Method, names map[string]string) {
+	visited := make(map[Type]bool)
+
+	var visit func(t Type)
+	visit = func(t Type) {
+		if t == nil || visited[t] {
+			return
+		}
+
+		visited[t] = true
+
+		if s := t.Schema(); s != nil && s.IsReference() {
+			if name, ok := names[refKey(s)]; ok {
+				setTypeName(t, name)
+			}
+		}
+
+		for _, child := range children(t) {
+			visit(child)
+		}
+	}
+
+	for _, t := range types {
+		visit(t)
+	}
+
+	for _, m := range methods {
+		for _, param := range m.Parameters {
+			if !param.Parameter.GoLow().IsReference() {
+				continue
+			}
+
+			if name, ok := names[parameterKey(param.Parameter)]; ok {
+				setTypeName(param.Type, name)
+			}
+		}
+
+		for _, t := range methodTypes(m) {
+			visit(t)
+		}
+	}
+}
+
+// newInterMediateRepresentationExternalSchemas returns the types of the schemas
+// and parameters defined in other files and referenced by the document, along
+// with the names of the types declared for them by refKey and parameterKey.
+func newInterMediateRepresentationExternalSchemas(
+	doc *libopenapi.DocumentModel[v3.Document], plugin Plugin,
+) ([]Type, map[string]string, error) {
+	e := &externalTypes{
+		root:   "",
+		plugin: plugin,
+		names:  make(map[string]string),
+		taken:  make(map[string]bool),
+		types:  make([]Type, 0),
+	}
+
+	if doc.Index != nil {
+		e.root = doc.Index.GetSpecAbsolutePath()
+	}
+
+	components := doc.Model.Components
+	if components != nil {
+		for schemaPair := components.Schemas.First(); schemaPair != nil; schemaPair = schemaPair.Next() {
+			e.taken[schemaPair.Key()] = true
+
+			// components referencing an external schema declare its type
+			if proxy := schemaPair.Value(); proxy.IsReference() && e.isExternal(proxy) {
+				if _, ok := e.names[e.componentKey(proxy)]; !ok {
+					e.names[e.componentKey(proxy)] = schemaPair.Key()
+				}
+			}
+		}
+
+		for paramPair := components.Parameters.First(); paramPair != nil; paramPair = paramPair.Next() {
+			e.taken[paramPair.Key()] = true
+		}
+
+		for schemaPair := components.Schemas.First(); schemaPair != nil; schemaPair = schemaPair.Next() {
+			if err := e.nested(schemaPair.Value()); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		for paramPair := components.Parameters.First(); paramPair != nil; paramPair = paramPair.Next() {
+			if err := e.schema(paramPair.Value().Schema); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if doc.Model.Paths == nil {
+		return e.types, e.names, nil
+	}
+
+	for pathPair := doc.Model.Paths.PathItems.First(); pathPair != nil; pathPair = pathPair.Next() {
+		for opPair := pathPair.Value().GetOperations().First(); opPair != nil; opPair = opPair.Next() {
+			if slices.Contains(opPair.Value().Tags, "excludeme") {
+				continue
+			}
+
+			if err := e.operation(pathPair.Value().Parameters, opPair.Value()); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return e.types, e.names, nil
+}
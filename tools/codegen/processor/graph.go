@@ -0,0 +1,246 @@
+package processor
+
+/*
+Types are declared in the order they are created: nested types before the object
+containing them and otherwise in the order of the document. Some languages need
+types to be declared before they are used, e.g. Python aliases, so the types can
+also be sorted by their dependencies:
+
+- A type depends on the declared types used by its properties, array items or
+  aliased type, e.g. `User` depends on `UserSettings` if it has a property of that
+  type.
+- Types depending on each other, directly or not, form a cycle and are grouped
+  together. Languages that support them need forward declarations or similar.
+- Groups are sorted so every group comes after the groups it depends on, otherwise
+  keeping the original order as much as possible.
+- Properties holding a type of the cycle of their object are flagged (see
+  Property.Cyclic) so languages storing values inline can box them.
+*/
+
+// TypeGroup is a set of types that depend on each other.
+type TypeGroup struct {
+	Types []Type
+	// Cyclic is true if the types depend on each other or, for a single type, on
+	// itself
+	Cyclic bool
+}
+
+// typeGraph is the dependency graph of a list of types.
+type typeGraph struct {
+	types []Type
+	// key is the name of the type, value the index in types
+	index map[string]int
+}
+
+func newTypeGraph(types []Type) *typeGraph {
+	g := &typeGraph{
+		types: types,
+		index: make(map[string]int, len(types)),
+	}
+
+	for i, t := range types {
+		if name, ok := typeName(t); ok {
+			if _, ok := g.index[name]; !ok {
+				g.index[name] = i
+			}
+		}
+	}
+
+	return g
+}
+
+// edges returns the index of the types the type at i depends on, in the order
+// they are used.
+func (g *typeGraph) edges(i int) []int {
+	edges := make([]int, 0)
+	seen := make(map[int]struct{})
+
+	var walk func(t Type)
+	walk = func(t Type) {
+		for _, child := range children(t) {
+			name, ok := typeName(child)
+			if !ok {
+				walk(child)
+				continue
+			}
+
+			j, ok := g.index[name]
+			if !ok {
+				// declared elsewhere, e.g. in the shared module
+				continue
+			}
+
+			if _, ok := seen[j]; !ok {
+				seen[j] = struct{}{}
+				edges = append(edges, j)
+			}
+		}
+	}
+
+	walk(g.types[i])
+
+	return edges
+}
+
+// groups returns the strongly connected components of the graph, dependencies
+// first, using Tarjan's algorithm.
+func (g *typeGraph) groups() []TypeGroup { //nolint:cyclop
+	const unvisited = -1
+
+	order := make([]int, len(g.types))
+	lowlink := make([]int, len(g.types))
+	onStack := make([]bool, len(g.types))
+	stack := make([]int, 0, len(g.types))
+	groups := make([]TypeGroup, 0, len(g.types))
+	counter := 0
+
+	for i := range order {
+		order[i] = unvisited
+	}
+
+	var connect func(i int)
+	connect = func(i int) {
+		order[i] = counter
+		lowlink[i] = counter
+		counter++
+
+		stack = append(stack, i)
+		onStack[i] = true
+
+		selfLoop := false
+
+		for _, j := range g.edges(i) {
+			switch {
+			case j == i:
+				selfLoop = true
+			case order[j] == unvisited:
+				connect(j)
+				lowlink[i] = min(lowlink[i], lowlink[j])
+			case onStack[j]:
+				lowlink[i] = min(lowlink[i], order[j])
+			}
+		}
+
+		if lowlink[i] != order[i] {
+			return
+		}
+
+		members := make([]bool, len(g.types))
+
+		for {
+			j := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[j] = false
+			members[j] = true
+
+			if j == i {
+				break
+			}
+		}
+
+		// keep the original order within the group
+		group := TypeGroup{Types: make([]Type, 0, 1), Cyclic: selfLoop}
+
+		for j, member := range members {
+			if member {
+				group.Types = append(group.Types, g.types[j])
+			}
+		}
+
+		group.Cyclic = group.Cyclic || len(group.Types) > 1
+		groups = append(groups, group)
+	}
+
+	for i := range g.types {
+		if order[i] == unvisited {
+			connect(i)
+		}
+	}
+
+	return groups
+}
+
+// markCyclic flags the properties of the objects in a cycle holding a type of the
+// same cycle.
+func markCyclic(types []Type) {
+	for _, group := range newTypeGraph(types).groups() {
+		if !group.Cyclic {
+			continue
+		}
+
+		names := make(map[string]struct{}, len(group.Types))
+		for _, t := range group.Types {
+			if name, ok := typeName(t); ok {
+				names[name] = struct{}{}
+			}
+		}
+
+		for _, t := range group.Types {
+			obj, ok := t.(*TypeObject)
+			if !ok {
+				continue
+			}
+
+			for _, prop := range obj.properties {
+				if name, ok := typeName(prop.Type); ok {
+					_, prop.cyclic = names[name]
+				}
+			}
+		}
+	}
+}
+
+// Dependencies returns the types of the representation that t uses directly.
+func (ir *InterMediateRepresentation) Dependencies(t Type) []Type {
+	g := newTypeGraph(ir.Types)
+
+	name, ok := typeName(t)
+	if !ok {
+		return nil
+	}
+
+	i, ok := g.index[name]
+	if !ok {
+		return nil
+	}
+
+	edges := g.edges(i)
+	types := make([]Type, len(edges))
+
+	for k, j := range edges {
+		types[k] = g.types[j]
+	}
+
+	return types
+}
+
+// TypeGroups returns the types grouped by cycles, every group after the groups it
+// depends on.
+func (ir *InterMediateRepresentation) TypeGroups() []TypeGroup {
+	return newTypeGraph(ir.Types).groups()
+}
+
+// TypesSorted returns the types so that every type comes after the types it
+// depends on, except for types in a cycle (see TypeGroups).
+func (ir *InterMediateRepresentation) TypesSorted() []Type {
+	return flattenGroups(ir.TypeGroups())
+}
+
+// TypeGroups is like InterMediateRepresentation.TypeGroups for the shared types.
+func (s *SharedRepresentation) TypeGroups() []TypeGroup {
+	return newTypeGraph(s.Types).groups()
+}
+
+// TypesSorted is like InterMediateRepresentation.TypesSorted for the shared types.
+func (s *SharedRepresentation) TypesSorted() []Type {
+	return flattenGroups(s.TypeGroups())
+}
+
+func flattenGroups(groups []TypeGroup) []Type {
+	types := make([]Type, 0, len(groups))
+	for _, group := range groups {
+		types = append(types, group.Types...)
+	}
+
+	return types
+}
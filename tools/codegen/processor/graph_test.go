@@ -0,0 +1,145 @@
+package processor_test
+
+import (
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypesSorted(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/graph.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	assert.Equal(t,
+		[]string{"Team", "Person", "Node", "Order", "Customer", "Priority"},
+		typeNames(ir.Types),
+	)
+
+	assert.Equal(t,
+		[]string{"Team", "Person", "Node", "Customer", "Priority", "Order"},
+		typeNames(ir.TypesSorted()),
+	)
+
+	groups := ir.TypeGroups()
+	got := make([][]string, len(groups))
+	cyclic := make([]bool, len(groups))
+
+	for i, group := range groups {
+		got[i] = typeNames(group.Types)
+		cyclic[i] = group.Cyclic
+	}
+
+	assert.Equal(t, [][]string{
+		{"Team", "Person"}, {"Node"}, {"Customer"}, {"Priority"}, {"Order"},
+	}, got)
+	assert.Equal(t, []bool{true, true, false, false, false}, cyclic)
+
+	assert.Equal(t, []string{"Customer", "Priority"}, typeNames(ir.Dependencies(ir.Types[3])))
+	assert.Empty(t, ir.Dependencies(ir.Types[4]))
+}
+
+func TestRecursiveReferences(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/graph.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	node, ok := ir.Types[2].(*processor.TypeObject)
+	if !ok {
+		t.Fatalf("expected Node to be an object, got %T", ir.Types[2])
+	}
+
+	children, ok := node.Properties()[0].Type.(*processor.TypeArray)
+	if !ok {
+		t.Fatalf("expected children to be an array, got %T", node.Properties()[0].Type)
+	}
+
+	// the reference to Node inside Node is resolved to the built object
+	child, ok := children.Item.(*processor.TypeObject)
+	if !ok {
+		t.Fatalf("expected children items to be objects, got %T", children.Item)
+	}
+
+	assert.Equal(t, "Node", child.Name())
+	assert.Len(t, child.Properties(), 1)
+
+	grandchildren, ok := child.Properties()[0].Type.(*processor.TypeArray)
+	if !ok {
+		t.Fatalf("expected children to be an array, got %T", child.Properties()[0].Type)
+	}
+
+	assert.Same(t, child, grandchildren.Item)
+
+	team, ok := ir.Types[0].(*processor.TypeObject)
+	if !ok {
+		t.Fatalf("expected Team to be an object, got %T", ir.Types[0])
+	}
+
+	lead, ok := team.Properties()[0].Type.(*processor.TypeObject)
+	if !ok {
+		t.Fatalf("expected lead to be an object, got %T", team.Properties()[0].Type)
+	}
+
+	leadTeam, ok := lead.Properties()[0].Type.(*processor.TypeObject)
+	if !ok {
+		t.Fatalf("expected team to be an object, got %T", lead.Properties()[0].Type)
+	}
+
+	assert.Equal(t, "Team", leadTeam.Name())
+	assert.Len(t, leadTeam.Properties(), 2)
+}
+
+func TestCyclicProperties(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/recursive.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	got := make(map[string]bool)
+
+	for _, typ := range ir.Types {
+		obj, ok := typ.(*processor.TypeObject)
+		if !ok {
+			continue
+		}
+
+		for _, prop := range obj.Properties() {
+			got[obj.Name()+"."+prop.WireName()] = prop.Cyclic()
+		}
+	}
+
+	assert.Equal(t, map[string]bool{
+		"Node.id":       false,
+		"Node.parent":   true,
+		"Node.children": false,
+		"Team.name":     false,
+		"Team.lead":     true,
+		"Person.name":   false,
+		"Person.team":   true,
+	}, got)
+}
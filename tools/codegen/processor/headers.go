@@ -0,0 +1,92 @@
+package processor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+type ResponseHeader struct {
+	// name of the header as sent by the server
+	name   string
+	Header *v3.Header
+	Type   Type
+}
+
+func (h *ResponseHeader) Name() string {
+	return h.name
+}
+
+func (h *ResponseHeader) Required() bool {
+	return h.Header.Required
+}
+
+func (h *ResponseHeader) Deprecated() bool {
+	return h.Header.Deprecated || schemaDeprecated(h.Header.Schema)
+}
+
+func getResponseHeaders(
+	operation *v3.Operation, response *v3.Response, p Plugin,
+) ([]*ResponseHeader, []Type, error) {
+	if response == nil || response.Headers == nil {
+		return nil, nil, nil
+	}
+
+	headers := make([]*ResponseHeader, 0, response.Headers.Len())
+	types := make([]Type, 0)
+
+	for pair := response.Headers.First(); pair != nil; pair = pair.Next() {
+		name := pair.Key()
+		header := pair.Value()
+
+		// as per the OpenAPI specification, Content-Type is described by the content field
+		if strings.EqualFold(name, "content-type") {
+			continue
+		}
+
+		// headers described with content instead of a schema are not supported
+		if header == nil || header.Schema == nil {
+			continue
+		}
+
+		derivedName := operation.OperationId + "Header" + format.ToCamelCase(name)
+
+		t, tt, err := GetType(header.Schema, derivedName, p, false)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get type for header %s: %w", name, err)
+		}
+
+		types = append(types, tt...)
+		headers = append(headers, &ResponseHeader{
+			name:   name,
+			Header: header,
+			Type:   t,
+		})
+	}
+
+	return headers, types, nil
+}
+
+// SuccessResponseHeaders returns the headers of all the successful responses of the
+// method. Headers present in more than one response are only returned once.
+func (m *Method) SuccessResponseHeaders() []*ResponseHeader {
+	headers := make([]*ResponseHeader, 0, 10) //nolint:mnd
+	for _, code := range m.successCodes() {
+		for _, header := range m.ResponseHeaders[code] {
+			if !slices.ContainsFunc(headers, func(h *ResponseHeader) bool {
+				return strings.EqualFold(h.name, header.name)
+			}) {
+				headers = append(headers, header)
+			}
+		}
+	}
+
+	return headers
+}
+
+func (m *Method) HasSuccessResponseHeaders() bool {
+	return len(m.SuccessResponseHeaders()) > 0
+}
@@ -0,0 +1,56 @@
+package processor_test
+
+import (
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethodResponseHeaders(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/methods_ref.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	var method *processor.Method
+
+	for _, m := range ir.Methods {
+		if m.Name() == "getFile" {
+			method = m
+		}
+	}
+
+	if method == nil {
+		t.Fatal("method getFile not found")
+	}
+
+	names := func(headers []*processor.ResponseHeader) map[string]string {
+		got := make(map[string]string, len(headers))
+		for _, h := range headers {
+			got[h.Name()] = h.Type.Name()
+		}
+
+		return got
+	}
+
+	fileHeaders := map[string]string{
+		"Cache-Control":  "string",
+		"Content-Length": "number",
+		"Etag":           "string",
+		"Last-Modified":  "string",
+	}
+
+	assert.Equal(t, fileHeaders, names(method.ResponseHeaders["200"]))
+	assert.Equal(t, fileHeaders, names(method.ResponseHeaders["304"]))
+	assert.Equal(t, map[string]string{"X-Error": "string"}, names(method.ResponseHeaders["400"]))
+	assert.Equal(t, fileHeaders, names(method.SuccessResponseHeaders()))
+}
@@ -21,6 +21,24 @@ type InterMediateRepresentation struct {
 	plugin  Plugin
 	Types   []Type
 	Methods []*Method
+	// Pruned are the types removed because no method uses them
+	Pruned []Type
+	// SharedTypes are the types of the service declared in the shared module
+	// when generating several services together
+	SharedTypes []Type
+	// SharedModule is how the service imports the shared module
+	SharedModule string
+}
+
+// Options controls how the intermediate representation is built.
+type Options struct {
+	// RenameCollisions renames types derived from schemas with different structures
+	// that end up with the same name instead of failing
+	RenameCollisions bool
+	// Prune removes the types not reachable from any method
+	Prune bool
+	// Keep lists the names of the types kept by Prune even if no method uses them
+	Keep []string
 }
 
 /*
@@ -31,9 +49,25 @@ In this represntation, we create:
 - Create a type for each schema in the OpenAPI document.
 - Create a type for each nested object inside another object.
 - Create a type for each enum in the OpenAPI document.
+- Create an input view for each object with readOnly or writeOnly properties used in a request.
+- Deduplicate the types sharing their name.
+- Optionally, remove the types that no method uses.
+- Flag the properties holding a type of the same cycle as their object.
 */
 func NewInterMediateRepresentation(
 	doc *libopenapi.DocumentModel[v3.Document], plugin Plugin,
+) (*InterMediateRepresentation, error) {
+	return NewInterMediateRepresentationWithOptions(doc, plugin, Options{
+		RenameCollisions: false,
+		Prune:            false,
+		Keep:             nil,
+	})
+}
+
+// NewInterMediateRepresentationWithOptions is like NewInterMediateRepresentation
+// with the given options.
+func NewInterMediateRepresentationWithOptions(
+	doc *libopenapi.DocumentModel[v3.Document], plugin Plugin, options Options,
 ) (*InterMediateRepresentation, error) {
 	types := make([]Type, 0, 10) //nolint:mnd
 
@@ -67,6 +101,13 @@ func NewInterMediateRepresentation(
 		types = append(types, types2...)
 	}
 
+	external, externalNames, err := newInterMediateRepresentationExternalSchemas(doc, plugin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create intermediate representation external schemas: %w", err)
+	}
+
+	types = append(types, external...)
+
 	var methods []*Method
 
 	if doc.Model.Paths != nil {
@@ -79,10 +120,36 @@ func NewInterMediateRepresentation(
 		methods = m
 	}
 
+	renameReferences(types, methods, externalNames)
+
+	types, err = dedupe(types, options.RenameCollisions)
+	if err != nil {
+		return nil, err
+	}
+
+	views := newInputViews(plugin)
+	views.apply(methods)
+
+	// input views can collide with other types
+	types, err = dedupe(views.insert(types), options.RenameCollisions)
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []Type
+	if options.Prune {
+		types, pruned = prune(types, methods, options.Keep)
+	}
+
+	markCyclic(types)
+
 	return &InterMediateRepresentation{
-		plugin:  plugin,
-		Types:   types,
-		Methods: methods,
+		plugin:       plugin,
+		Types:        types,
+		Methods:      methods,
+		Pruned:       pruned,
+		SharedTypes:  nil,
+		SharedModule: "",
 	}, nil
 }
 
@@ -96,7 +163,9 @@ func newInterMediateRepresentationComponentsSchemas(
 		proxy := schemaPairs.Value()
 
 		if proxy.Schema() != nil && len(proxy.Schema().Type) > 0 {
-			_, tt, err := GetType(proxy, schemaName, plugin, true)
+			// components referencing other schemas, e.g. in other files, define
+			// a type with the name of the component
+			_, tt, err := GetType(dereference(proxy), schemaName, plugin, true)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create type %s: %w", schemaName, err)
 			}
@@ -146,7 +215,7 @@ func newInterMediateRepresentationPaths(
 				continue
 			}
 
-			m, tt, err := GetMethod(path, opPairs.Key(), opPairs.Value(), plugin)
+			m, tt, err := GetMethod(path, opPairs.Key(), item.Parameters, opPairs.Value(), plugin)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to create method for path %s: %w", path, err)
 			}
@@ -159,12 +228,12 @@ func newInterMediateRepresentationPaths(
 	return methods, types, nil
 }
 
-func (ir *InterMediateRepresentation) Render(out io.Writer) error {
-	templatesFS := ir.plugin.GetTemplates()
+func parseTemplates(plugin Plugin) (*template.Template, error) {
+	templatesFS := plugin.GetTemplates()
 	// ReadDir to get list of embedded templates
 	entries, err := fs.ReadDir(templatesFS, "templates")
 	if err != nil {
-		return fmt.Errorf("failed to read templates directory: %w", err)
+		return nil, fmt.Errorf("failed to read templates directory: %w", err)
 	}
 
 	var filenames []string
@@ -175,18 +244,38 @@ func (ir *InterMediateRepresentation) Render(out io.Writer) error {
 		}
 	}
 
+	var tmpl *template.Template
+
 	funcs := template.FuncMap{
 		"title":   format.Title,
 		"join":    strings.Join,
 		"example": templateFnExample,
 		"pattern": templateFnPattern,
 		"format":  templateFnFormat,
+		"indent":  templateFnIndent,
+		// include is like the template action but returns the output, e.g. to
+		// indent it
+		"include": func(name string, data any) (string, error) {
+			var b strings.Builder
+			err := tmpl.ExecuteTemplate(&b, name, data)
+
+			return b.String(), err //nolint:wrapcheck
+		},
+	}
+	maps.Copy(funcs, plugin.GetFuncMap())
+
+	tmpl, err = template.New("").Funcs(funcs).ParseFS(templatesFS, filenames...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse interface template: %w", err)
 	}
-	maps.Copy(funcs, ir.plugin.GetFuncMap())
 
-	tmpl, err := template.New("").Funcs(funcs).ParseFS(templatesFS, filenames...)
+	return tmpl, nil
+}
+
+func (ir *InterMediateRepresentation) Render(out io.Writer) error {
+	tmpl, err := parseTemplates(ir.plugin)
 	if err != nil {
-		return fmt.Errorf("failed to parse interface template: %w", err)
+		return err
 	}
 
 	if err := tmpl.ExecuteTemplate(out, "main.tmpl", ir); err != nil {
@@ -222,6 +311,18 @@ func templateFnPattern(obj getSchemaer) string {
 	return obj.Schema().Schema().Pattern
 }
 
+// templateFnIndent indents the non-empty lines of s with n spaces.
+func templateFnIndent(n int, s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = strings.Repeat(" ", n) + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func templateFnFormat(obj getSchemaer) string {
 	return obj.Schema().Schema().Format
 }
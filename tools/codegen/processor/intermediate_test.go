@@ -2,38 +2,30 @@ package processor_test
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/csharp"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/dart"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/jsonschema"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/kotlin"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/msw"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/python"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/rust"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/swift"
 	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
 	"github.com/pb33f/libopenapi"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/stretchr/testify/assert"
 )
 
 func getModel(filepath string) (*libopenapi.DocumentModel[v3.Document], error) {
-	// Read OpenAPI file
-	b, err := os.ReadFile(filepath)
+	docModel, err := spec.Load(filepath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read openapi spec: %w", err)
-	}
-
-	document, err := libopenapi.NewDocument(b)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create new document: %w", err)
-	}
-
-	docModel, errorsList := document.BuildV3Model()
-	if len(errorsList) > 0 {
-		var wrappedError error
-		for i := range errorsList {
-			wrappedError = errors.Join(wrappedError, errorsList[i])
-		}
-
-		return nil, fmt.Errorf("cannot create v3 model from document: %w", wrappedError)
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
 	}
 
 	return docModel, nil
@@ -44,20 +36,243 @@ func TestInterMediateRepresentationRender(t *testing.T) {
 
 	cases := []struct {
 		name string
+		// plugin defaults to the typescript plugin
+		plugin processor.Plugin
+		// golden defaults to name + ".ts"
+		golden string
 	}{
 		{
-			name: "types.yaml",
+			name:   "types.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "content.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "readonly.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "deprecated.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "query_styles.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "path_styles.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "path_parameters.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "constraints.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "constraints.yaml",
+			plugin: &typescript.Typescript{Zod: true, Validators: false},
+			golden: "constraints.yaml.zod.ts",
+		},
+		{
+			name:   "constraints.yaml",
+			plugin: &typescript.Typescript{Zod: false, Validators: true},
+			golden: "constraints.yaml.validators.ts",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &typescript.Typescript{Zod: false, Validators: true},
+			golden: "methods_ref.yaml.validators.ts",
+		},
+		{
+			name:   "readonly.yaml",
+			plugin: &typescript.Typescript{Zod: true, Validators: false},
+			golden: "readonly.yaml.zod.ts",
+		},
+		{
+			name:   "types.yaml",
+			plugin: &typescript.Typescript{Zod: true, Validators: false},
+			golden: "types.yaml.zod.ts",
+		},
+		{
+			name:   "recursive.yaml",
+			plugin: &typescript.Typescript{Zod: true, Validators: false},
+			golden: "recursive.yaml.zod.ts",
+		},
+		{
+			name:   "types.yaml",
+			plugin: &python.Python{},
+			golden: "types.yaml.py",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &python.Python{},
+			golden: "methods_ref.yaml.py",
+		},
+		{
+			name:   "readonly.yaml",
+			plugin: &python.Python{},
+			golden: "readonly.yaml.py",
+		},
+		{
+			name:   "types.yaml",
+			plugin: &dart.Dart{},
+			golden: "types.yaml.dart",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &dart.Dart{},
+			golden: "methods_ref.yaml.dart",
+		},
+		{
+			name:   "query_styles.yaml",
+			plugin: &dart.Dart{},
+			golden: "query_styles.yaml.dart",
 		},
 		{
-			name: "methods_ref.yaml",
+			name:   "types.yaml",
+			plugin: &swift.Swift{},
+			golden: "types.yaml.swift",
 		},
 		{
-			name: "content.yaml",
+			name:   "methods_ref.yaml",
+			plugin: &swift.Swift{},
+			golden: "methods_ref.yaml.swift",
+		},
+		{
+			name:   "query_styles.yaml",
+			plugin: &swift.Swift{},
+			golden: "query_styles.yaml.swift",
+		},
+		{
+			name:   "recursive.yaml",
+			plugin: &swift.Swift{},
+			golden: "recursive.yaml.swift",
+		},
+		{
+			name:   "types.yaml",
+			plugin: &kotlin.Kotlin{Package: ""},
+			golden: "types.yaml.kt",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &kotlin.Kotlin{Package: ""},
+			golden: "methods_ref.yaml.kt",
+		},
+		{
+			name:   "query_styles.yaml",
+			plugin: &kotlin.Kotlin{Package: ""},
+			golden: "query_styles.yaml.kt",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &kotlin.Kotlin{Package: "io.nhost.storage"},
+			golden: "methods_ref.yaml.package.kt",
+		},
+		{
+			name:   "types.yaml",
+			plugin: &rust.Rust{},
+			golden: "types.yaml.rs",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &rust.Rust{},
+			golden: "methods_ref.yaml.rs",
+		},
+		{
+			name:   "query_styles.yaml",
+			plugin: &rust.Rust{},
+			golden: "query_styles.yaml.rs",
+		},
+		{
+			name:   "recursive.yaml",
+			plugin: &rust.Rust{},
+			golden: "recursive.yaml.rs",
+		},
+		{
+			name:   "types.yaml",
+			plugin: &csharp.CSharp{Namespace: ""},
+			golden: "types.yaml.cs",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &csharp.CSharp{Namespace: ""},
+			golden: "methods_ref.yaml.cs",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &csharp.CSharp{Namespace: "Nhost.Storage"},
+			golden: "methods_ref.yaml.namespace.cs",
+		},
+		{
+			name:   "query_styles.yaml",
+			plugin: &csharp.CSharp{Namespace: ""},
+			golden: "query_styles.yaml.cs",
+		},
+		{
+			name:   "types.yaml",
+			plugin: &jsonschema.JSONSchema{ID: "https://example.com/schemas/types"},
+			golden: "types.yaml.json",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &jsonschema.JSONSchema{ID: "https://example.com/schemas/methods-ref"},
+			golden: "methods_ref.yaml.json",
+		},
+		{
+			name:   "readonly.yaml",
+			plugin: &jsonschema.JSONSchema{ID: "https://example.com/schemas/readonly"},
+			golden: "readonly.yaml.json",
+		},
+		{
+			name:   "multi_file/api.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "external_collision/api.yaml",
+			plugin: nil,
+			golden: "",
+		},
+		{
+			name:   "methods_ref.yaml",
+			plugin: &msw.MSW{}, //nolint:exhaustruct
+			golden: "methods_ref.yaml.msw.ts",
+		},
+		{
+			name:   "path_styles.yaml",
+			plugin: &msw.MSW{}, //nolint:exhaustruct
+			golden: "path_styles.yaml.msw.ts",
 		},
 	}
 
 	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
+		if tc.plugin == nil {
+			tc.plugin = &typescript.Typescript{} //nolint:exhaustruct
+		}
+
+		if tc.golden == "" {
+			tc.golden = tc.name + ".ts"
+		}
+
+		t.Run(tc.golden, func(t *testing.T) {
 			t.Parallel()
 
 			doc, err := getModel("testdata/" + tc.name)
@@ -65,7 +280,7 @@ func TestInterMediateRepresentationRender(t *testing.T) {
 				t.Fatalf("failed to get model: %v", err)
 			}
 
-			ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+			ir, err := processor.NewInterMediateRepresentation(doc, tc.plugin)
 			if err != nil {
 				t.Fatalf("failed to create intermediate representation: %v", err)
 			}
@@ -78,7 +293,7 @@ func TestInterMediateRepresentationRender(t *testing.T) {
 			output := buf.String()
 
 			// f, err := os.OpenFile(
-			// 	"testdata/"+tc.name+".ts",
+			// 	"testdata/"+tc.golden,
 			// 	os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
 			// 	0o644,
 			// )
@@ -90,13 +305,13 @@ func TestInterMediateRepresentationRender(t *testing.T) {
 			// 	t.Fatalf("failed to write output file: %v", err)
 			// }
 
-			b, err := os.ReadFile("testdata/" + tc.name + ".ts")
+			b, err := os.ReadFile("testdata/" + tc.golden)
 			if err != nil {
 				t.Fatalf("failed to read expected output file: %v", err)
 			}
 
 			assert.Equal(t, string(b), output,
-				"rendered output does not match expected output for %s", tc.name)
+				"rendered output does not match expected output for %s", tc.golden)
 		})
 	}
 }
@@ -0,0 +1,85 @@
+package jsonschema
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// JSONSchema generates a JSON Schema (Draft 2020-12) bundle with a definition for
+// every type of the intermediate representation. Types are named like in the
+// typescript plugin so the definitions match the types exposed by the TS SDK.
+type JSONSchema struct {
+	// ID is the $id of the bundle, the $id of each definition is the ID followed
+	// by the name of the type
+	ID string
+}
+
+func (j *JSONSchema) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (j *JSONSchema) GetFuncMap() map[string]any {
+	return map[string]any{
+		"bundle": j.bundle,
+	}
+}
+
+func (j *JSONSchema) TypeObjectName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (j *JSONSchema) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (j *JSONSchema) TypeScalarName(scalar *processor.TypeScalar) string {
+	return scalar.Schema().Schema().Type[0]
+}
+
+func (j *JSONSchema) TypeArrayName(array *processor.TypeArray) string {
+	return array.Item.Name() + "[]"
+}
+
+func (j *JSONSchema) TypeEnumName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (j *JSONSchema) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+	for i, v := range values {
+		enumValues[i] = fmt.Sprint(v)
+	}
+
+	return enumValues
+}
+
+func (j *JSONSchema) TypeMapName(_ *processor.TypeMap) string {
+	return "object"
+}
+
+func (j *JSONSchema) MethodName(name string) string {
+	return format.AntiTitle(format.ToCamelCase(name))
+}
+
+func (j *JSONSchema) MethodPath(_ []*processor.PathSegment) string {
+	return ""
+}
+
+func (j *JSONSchema) ParameterName(name string) string {
+	return name
+}
+
+func (j *JSONSchema) PropertyName(name string) string {
+	return name
+}
+
+func (j *JSONSchema) BinaryType() string {
+	return "string"
+}
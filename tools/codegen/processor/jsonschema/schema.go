@@ -0,0 +1,330 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"gopkg.in/yaml.v3"
+)
+
+const draft = "https://json-schema.org/draft/2020-12/schema"
+
+// member is a keyword of a schema and its value.
+type member struct {
+	key   string
+	value any
+}
+
+// object is a JSON object that keeps the order of its members, so definitions and
+// properties follow the order of the OpenAPI document.
+type object []member
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteByte('{')
+
+	for i, m := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		key, err := marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := marshal(m.value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", m.key, err)
+		}
+
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// marshal encodes v as JSON without escaping HTML characters, which are common in
+// descriptions and patterns.
+func marshal(v any) ([]byte, error) {
+	var b bytes.Buffer
+
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// bundle returns the JSON Schema document with a definition for each type.
+func (j *JSONSchema) bundle(ir *processor.InterMediateRepresentation) (string, error) {
+	defs := make(object, 0, len(ir.Types))
+
+	for _, t := range ir.Types {
+		if slices.ContainsFunc(defs, func(m member) bool { return m.key == t.Name() }) {
+			continue
+		}
+
+		defs = append(defs, member{key: t.Name(), value: j.definition(t)})
+	}
+
+	doc := object{
+		{key: "$schema", value: draft},
+		{key: "$id", value: j.ID},
+		{key: "$defs", value: defs},
+	}
+
+	var b bytes.Buffer
+
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(doc); err != nil {
+		return "", fmt.Errorf("failed to encode bundle: %w", err)
+	}
+
+	return b.String(), nil
+}
+
+// id returns the $id of the definition of the type named name.
+func (j *JSONSchema) id(name string) string {
+	return strings.TrimSuffix(j.ID, "/") + "/" + name
+}
+
+// definition returns the schema in $defs for a named type.
+func (j *JSONSchema) definition(t processor.Type) object {
+	s := object{
+		{key: "$id", value: j.id(t.Name())},
+		{key: "title", value: t.Name()},
+	}
+
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		s = append(s, annotations(t.Schema().Schema())...)
+		s = append(s, member{key: "type", value: types(t.Schema().Schema(), "object")})
+		s = append(s, j.properties(t)...)
+	case *processor.TypeEnum:
+		s = append(s, annotations(t.Schema().Schema())...)
+		s = append(s, member{key: "enum", value: enumValues(t)})
+	case *processor.TypeAlias:
+		s = append(s, j.reference(t.Alias())...)
+	default:
+		s = append(s, j.reference(t)...)
+	}
+
+	return s
+}
+
+func (j *JSONSchema) properties(t *processor.TypeObject) object {
+	properties := make(object, 0, len(t.Properties()))
+	required := make([]string, 0, len(t.Properties()))
+
+	for _, prop := range t.Properties() {
+		s := j.reference(prop.Type)
+		if prop.Deprecated() && !slices.ContainsFunc(s, func(m member) bool { return m.key == "deprecated" }) {
+			s = append(s, member{key: "deprecated", value: true})
+		}
+
+		properties = append(properties, member{key: prop.WireName(), value: s})
+
+		if prop.Required() {
+			required = append(required, prop.WireName())
+		}
+	}
+
+	s := object{{key: "properties", value: properties}}
+	if len(required) > 0 {
+		s = append(s, member{key: "required", value: required})
+	}
+
+	if ap := t.Schema().Schema().AdditionalProperties; ap != nil && ap.IsB() && !ap.B {
+		s = append(s, member{key: "additionalProperties", value: false})
+	}
+
+	return s
+}
+
+// reference returns the schema used where the type is referenced from another
+// schema. Named types are referenced by their $id.
+func (j *JSONSchema) reference(t processor.Type) object {
+	switch t := t.(type) {
+	case *processor.TypeObject, *processor.TypeEnum, *processor.TypeAlias:
+		return object{{key: "$ref", value: j.id(t.Name())}}
+	case *processor.TypeArray:
+		schema := t.Schema().Schema()
+		c := processor.GetConstraints(t)
+
+		s := annotations(schema)
+		s = append(s,
+			member{key: "type", value: types(schema, "array")},
+			member{key: "items", value: j.reference(t.Item)},
+		)
+
+		if c.MinItems != nil {
+			s = append(s, member{key: "minItems", value: *c.MinItems})
+		}
+
+		if c.MaxItems != nil {
+			s = append(s, member{key: "maxItems", value: *c.MaxItems})
+		}
+
+		return s
+	case *processor.TypeMap:
+		s := annotations(t.Schema().Schema())
+
+		return append(s, member{key: "type", value: types(t.Schema().Schema(), "object")})
+	default:
+		return scalar(t)
+	}
+}
+
+func scalar(t processor.Type) object {
+	if t.Schema() == nil || t.Schema().Schema() == nil {
+		return object{}
+	}
+
+	schema := t.Schema().Schema()
+	c := processor.GetConstraints(t)
+
+	s := annotations(schema)
+	if len(schema.Type) > 0 {
+		s = append(s, member{key: "type", value: types(schema, schema.Type[0])})
+	}
+
+	if len(schema.Enum) > 0 {
+		values := make([]any, 0, len(schema.Enum))
+		for _, node := range schema.Enum {
+			values = append(values, decode(node))
+		}
+
+		s = append(s, member{key: "enum", value: values})
+	}
+
+	optional := []member{
+		{key: "format", value: c.Format},
+		{key: "pattern", value: c.Pattern},
+		{key: "minLength", value: c.MinLength},
+		{key: "maxLength", value: c.MaxLength},
+	}
+
+	if c.ExclusiveMinimum {
+		optional = append(optional, member{key: "exclusiveMinimum", value: c.Minimum})
+	} else {
+		optional = append(optional, member{key: "minimum", value: c.Minimum})
+	}
+
+	if c.ExclusiveMaximum {
+		optional = append(optional, member{key: "exclusiveMaximum", value: c.Maximum})
+	} else {
+		optional = append(optional, member{key: "maximum", value: c.Maximum})
+	}
+
+	for _, m := range optional {
+		if !isZero(m.value) {
+			s = append(s, m)
+		}
+	}
+
+	return s
+}
+
+func isZero(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return v == ""
+	case *int64:
+		return v == nil
+	case *float64:
+		return v == nil
+	default:
+		return v == nil
+	}
+}
+
+// annotations returns the keywords describing a schema that don't affect
+// validation.
+func annotations(schema *base.Schema) object {
+	s := make(object, 0, 6) //nolint:mnd
+
+	if schema.Description != "" {
+		s = append(s, member{key: "description", value: schema.Description})
+	}
+
+	if schema.Deprecated != nil && *schema.Deprecated {
+		s = append(s, member{key: "deprecated", value: true})
+	}
+
+	if schema.ReadOnly != nil && *schema.ReadOnly {
+		s = append(s, member{key: "readOnly", value: true})
+	}
+
+	if schema.WriteOnly != nil && *schema.WriteOnly {
+		s = append(s, member{key: "writeOnly", value: true})
+	}
+
+	if schema.Default != nil {
+		s = append(s, member{key: "default", value: decode(schema.Default)})
+	}
+
+	if schema.Example != nil {
+		s = append(s, member{key: "examples", value: []any{decode(schema.Example)}})
+	}
+
+	return s
+}
+
+// types returns the type keyword of the schema, adding null to nullable schemas
+// as OpenAPI 3.0 uses a separate keyword for it.
+func types(schema *base.Schema, fallback string) any {
+	values := slices.Clone(schema.Type)
+	if len(values) == 0 {
+		values = []string{fallback}
+	}
+
+	if schema.Nullable != nil && *schema.Nullable && !slices.Contains(values, "null") {
+		values = append(values, "null")
+	}
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
+
+func enumValues(t *processor.TypeEnum) []any {
+	values := make([]any, 0, len(t.EnumValues())+1)
+	for _, v := range t.EnumValues() {
+		values = append(values, v.Raw())
+	}
+
+	if processor.GetConstraints(t).Nullable && !slices.Contains(values, nil) {
+		values = append(values, nil)
+	}
+
+	return values
+}
+
+// decode returns the value of a YAML node, or nil if it can't be decoded.
+func decode(node *yaml.Node) any {
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return nil
+	}
+
+	return v
+}
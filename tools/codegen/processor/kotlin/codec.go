@@ -0,0 +1,215 @@
+package kotlin
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func isBinary(t processor.Type) bool {
+	return processor.ScalarType(t) == "string" && processor.GetConstraints(t).Format == "binary"
+}
+
+// kotlinFieldType returns the type of the property for prop.
+func kotlinFieldType(prop *processor.Property) string {
+	if !prop.Required() || processor.GetConstraints(prop.Type).Nullable {
+		return prop.Type.Name() + "?"
+	}
+
+	return prop.Type.Name()
+}
+
+// EnumMember is an object of a generated sealed class.
+type EnumMember struct {
+	Name string
+	// Value is the JsonElement the member stands for
+	Value      string
+	Deprecated bool
+}
+
+//nolint:gochecknoglobals
+var enumReserved = []string{"Unknown", "Companion", "Serializer"}
+
+// kotlinEnumMembers returns the members of the enum with unique PascalCase names
+// derived from their values.
+func kotlinEnumMembers(t *processor.TypeEnum) []*EnumMember {
+	members := make([]*EnumMember, 0, len(t.EnumValues()))
+
+	for _, v := range t.EnumValues() {
+		name := format.Title(format.ToLowerCamelCase(fmt.Sprint(v.Raw())))
+
+		switch {
+		case name == "" || (name[0] >= '0' && name[0] <= '9'):
+			name = "Value" + name
+		case slices.Contains(enumReserved, name):
+			name += "Value"
+		}
+
+		unique := name
+		for i := 2; slices.ContainsFunc(members, func(m *EnumMember) bool {
+			return m.Name == unique
+		}); i++ {
+			unique = fmt.Sprintf("%s%d", name, i)
+		}
+
+		members = append(members, &EnumMember{
+			Name:       unique,
+			Value:      v.Value(),
+			Deprecated: v.Deprecated(),
+		})
+	}
+
+	return members
+}
+
+// kotlinFormTypes returns the multipart request bodies of the methods. Each of
+// them gets a function converting it into an OkHttp MultipartBody.
+func kotlinFormTypes(methods []*processor.Method) []*processor.TypeObject {
+	types := make([]*processor.TypeObject, 0)
+
+	for _, m := range methods {
+		t, ok := m.RequestFormData().(*processor.TypeObject)
+		if !ok || slices.ContainsFunc(types, func(o *processor.TypeObject) bool {
+			return o.Name() == t.Name()
+		}) {
+			continue
+		}
+
+		types = append(types, t)
+	}
+
+	return types
+}
+
+// kotlinFormField returns the statement that adds prop, read from expr, to the
+// multipart builder in scope. Binary values are sent as files, objects as JSON
+// parts and everything else as text fields.
+func kotlinFormField(prop *processor.Property, expr string) string {
+	key := kotlinString(prop.WireName())
+
+	part := func(t processor.Type, value string) string {
+		switch {
+		case isBinary(t):
+			return fmt.Sprintf("addFile(%s, %s)", key, value)
+		case t.Kind() == processor.KindIdentifierObject || t.Kind() == processor.KindIdentifierMap:
+			return fmt.Sprintf("addJson(%s, %s)", key, value)
+		default:
+			return fmt.Sprintf("addText(%s, %s)", key, value)
+		}
+	}
+
+	optional := ""
+	if !prop.Required() || processor.GetConstraints(prop.Type).Nullable {
+		optional = "?"
+	}
+
+	if t, ok := prop.Type.(*processor.TypeArray); ok {
+		return fmt.Sprintf("%s%s.forEach { %s }", expr, optional, part(t.Item, "it"))
+	}
+
+	if optional != "" {
+		return fmt.Sprintf("%s?.let { %s }", expr, part(prop.Type, "it"))
+	}
+
+	return part(prop.Type, expr)
+}
+
+func responseType(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return "Unit"
+	case r.MediaType == "application/json" && r.Type != nil:
+		return r.Type.Name()
+	case r.MediaType == "application/json":
+		return "JsonElement"
+	default:
+		return "ByteArray"
+	}
+}
+
+// kotlinReturnType returns the type of the body of the FetchResponse returned by
+// m. Methods returning different types per status code return the raw body.
+func kotlinReturnType(m *processor.Method) string {
+	types := make([]string, 0, 4) //nolint:mnd
+	for _, r := range m.SuccessResponses() {
+		if t := responseType(r); !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+
+	switch len(types) {
+	case 0:
+		return "Unit"
+	case 1:
+		return types[0]
+	default:
+		return "ByteArray"
+	}
+}
+
+// kotlinDecodeResponse returns a Kotlin expression reading the body of r from `data`.
+func kotlinDecodeResponse(m *processor.Method, r *processor.SuccessResponse) string {
+	if kotlinReturnType(m) == "ByteArray" {
+		return "data"
+	}
+
+	switch t := responseType(r); t {
+	case "Unit":
+		return "Unit"
+	default:
+		return "json.decodeFromString<" + t + ">(data.decodeToString())"
+	}
+}
+
+// errorTypes decodes the bodies of error responses without a schema as JsonElement.
+//
+//nolint:gochecknoglobals
+var errorTypes = processor.ErrorTypes{Fallback: "JsonElement"}
+
+func kotlinBodyType(m *processor.Method) string {
+	switch {
+	case m.RequestJSON() != nil:
+		return m.RequestJSON().Name()
+	case m.RequestFormData() != nil:
+		return m.RequestFormData().Name()
+	default:
+		return "ByteArray"
+	}
+}
+
+// kotlinArguments returns the parameter list of the method, one per line indented
+// with indent spaces and with a trailing comma.
+func kotlinArguments(m *processor.Method, indent int) string {
+	args := make([]string, 0, len(m.Parameters)+3) //nolint:mnd
+
+	for _, param := range m.PathParameters() {
+		args = append(args, param.Name()+": "+param.Type.Name())
+	}
+
+	if m.RequestHasBody() && !m.IsRedirect() {
+		if m.BodyRequired {
+			args = append(args, "body: "+kotlinBodyType(m))
+		} else {
+			args = append(args, "body: "+kotlinBodyType(m)+"? = null")
+		}
+	}
+
+	if m.HasQueryParameters() {
+		args = append(args, "params: "+format.Title(unquote(m.Name()))+"Params? = null")
+	}
+
+	if !m.IsRedirect() {
+		args = append(args, "headers: Map<String, String> = emptyMap()")
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+
+	return "\n" + prefix + "    " + strings.Join(args, ",\n"+prefix+"    ") + ",\n" + prefix
+}
@@ -0,0 +1,235 @@
+package kotlin
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// Kotlin generates kotlinx.serialization data classes, sealed classes for enums
+// with an unknown-value fallback and a client with suspend functions built on
+// OkHttp.
+type Kotlin struct {
+	// Package of the generated code, the default package if empty
+	Package string
+}
+
+func (k *Kotlin) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (k *Kotlin) GetFuncMap() map[string]any {
+	return map[string]any{
+		"kotlinPackage":        func() string { return k.Package },
+		"kotlinString":         kotlinString,
+		"kotlinDoc":            kotlinDoc,
+		"kotlinDeprecated":     kotlinDeprecated,
+		"kotlinFieldType":      kotlinFieldType,
+		"kotlinEnumMembers":    kotlinEnumMembers,
+		"kotlinFormTypes":      kotlinFormTypes,
+		"kotlinFormField":      kotlinFormField,
+		"kotlinReturnType":     kotlinReturnType,
+		"kotlinDecodeResponse": kotlinDecodeResponse,
+		"kotlinErrorResponses": errorTypes.Responses,
+		"kotlinErrorType":      errorTypes.Type,
+		"kotlinErrorDefault":   errorTypes.Default,
+		"kotlinArguments":      kotlinArguments,
+		"kotlinBodyType":       kotlinBodyType,
+		"unquote":              unquote,
+	}
+}
+
+//nolint:gochecknoglobals
+var keywords = []string{
+	"as", "break", "class", "continue", "do", "else", "false", "for", "fun", "if", "in",
+	"interface", "is", "null", "object", "package", "return", "super", "this", "throw",
+	"true", "try", "typealias", "typeof", "val", "var", "when", "while",
+}
+
+// identifier converts name to lowerCamelCase and escapes it with backticks if it
+// is a hard keyword.
+func identifier(name string) string {
+	name = format.ToLowerCamelCase(name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	if slices.Contains(keywords, name) {
+		return "`" + name + "`"
+	}
+
+	return name
+}
+
+// unquote removes the backticks escaping a keyword, which are not valid in every
+// position (e.g. as part of a longer name).
+func unquote(name string) string {
+	return strings.Trim(name, "`")
+}
+
+func (k *Kotlin) TypeObjectName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (k *Kotlin) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (k *Kotlin) TypeScalarName(scalar *processor.TypeScalar) string {
+	schema := scalar.Schema().Schema()
+
+	switch schema.Type[0] {
+	case "string":
+		if schema.Format == "binary" {
+			return "Base64Bytes"
+		}
+
+		return "String"
+	case "integer":
+		if schema.Format == "int64" {
+			return "Long"
+		}
+
+		return "Int"
+	case "number":
+		return "Double"
+	case "boolean":
+		return "Boolean"
+	default:
+		return "JsonElement"
+	}
+}
+
+func (k *Kotlin) TypeArrayName(array *processor.TypeArray) string {
+	return "List<" + array.Item.Name() + ">"
+}
+
+func (k *Kotlin) TypeEnumName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+// TypeEnumValues returns the values as JsonElement expressions, which is how the
+// members of the sealed classes are matched while decoding.
+func (k *Kotlin) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			enumValues[i] = "JsonPrimitive(" + kotlinString(v) + ")"
+		case nil:
+			enumValues[i] = "JsonNull"
+		default:
+			enumValues[i] = fmt.Sprintf("JsonPrimitive(%v)", v)
+		}
+	}
+
+	return enumValues
+}
+
+func (k *Kotlin) TypeMapName(_ *processor.TypeMap) string {
+	return "Map<String, JsonElement>"
+}
+
+func (k *Kotlin) MethodName(name string) string {
+	return identifier(name)
+}
+
+// MethodPath returns a Kotlin expression that builds the path of the method.
+func (k *Kotlin) MethodPath(segments []*processor.PathSegment) string {
+	parts := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		if !segment.IsParameter() {
+			parts = append(parts, kotlinString(segment.Literal))
+			continue
+		}
+
+		param := segment.Parameter
+		parts = append(parts, fmt.Sprintf(
+			"serializePath(%s, %s, %s, %t)",
+			kotlinString(param.WireName()),
+			param.Name(),
+			kotlinString(string(param.Style())),
+			param.Explode(),
+		))
+	}
+
+	if len(parts) == 0 {
+		return `""`
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+func (k *Kotlin) ParameterName(name string) string {
+	return identifier(name)
+}
+
+func (k *Kotlin) PropertyName(name string) string {
+	return identifier(name)
+}
+
+func (k *Kotlin) BinaryType() string {
+	return "ByteArray"
+}
+
+// kotlinString returns s as a Kotlin string literal.
+//
+//nolint:gochecknoglobals
+var kotlinString = processor.StringLiteral{Interpolation: "$", BracedUnicode: false, SingleQuoted: false}.Quote
+
+// kotlinDoc returns a KDoc comment indented with indent spaces built from the
+// non-empty parts, or an empty string if there is nothing to document.
+func kotlinDoc(indent int, parts ...string) string {
+	paragraphs := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			paragraphs = append(paragraphs, strings.ReplaceAll(part, "*/", "*&#47;"))
+		}
+	}
+
+	if len(paragraphs) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+	lines := strings.Split(strings.Join(paragraphs, "\n\n"), "\n")
+
+	if len(lines) == 1 {
+		return prefix + "/** " + lines[0] + " */"
+	}
+
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = prefix + " *"
+		} else {
+			lines[i] = prefix + " * " + line
+		}
+	}
+
+	return prefix + "/**\n" + strings.Join(lines, "\n") + "\n" + prefix + " */"
+}
+
+// kotlinDeprecated returns the @Deprecated annotation for an element, or an empty
+// string if it isn't deprecated.
+func kotlinDeprecated(deprecated bool, message string) string {
+	if !deprecated {
+		return ""
+	}
+
+	if message == "" {
+		message = "deprecated"
+	}
+
+	return "@Deprecated(" + kotlinString(message) + ")"
+}
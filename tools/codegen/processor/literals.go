@@ -0,0 +1,62 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// StringLiteral formats strings as literals of the generated language. Backslashes,
+// quotes, newlines, carriage returns and tabs are escaped with a backslash and the
+// other control characters with their code point. The zero value produces JSON
+// strings, which are valid in TypeScript, Python and C#.
+type StringLiteral struct {
+	// Interpolation lists the characters starting an interpolation in a string,
+	// e.g. $ in Kotlin and Dart, which are escaped with a backslash
+	Interpolation string
+	// BracedUnicode escapes code points as \u{7f} instead of \u007f, e.g. in Swift
+	// and Rust
+	BracedUnicode bool
+	// SingleQuoted delimits the literals with single quotes instead of double
+	// quotes, e.g. in Dart
+	SingleQuoted bool
+}
+
+// Quote returns s as a string literal.
+func (l StringLiteral) Quote(s string) string {
+	quote := '"'
+	if l.SingleQuoted {
+		quote = '\''
+	}
+
+	var b strings.Builder
+
+	b.WriteRune(quote)
+
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == quote || strings.ContainsRune(l.Interpolation, r):
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		// line and paragraph separators end string literals in older JavaScript
+		case unicode.IsControl(r) || r == '\u2028' || r == '\u2029':
+			if l.BracedUnicode {
+				fmt.Fprintf(&b, `\u{%x}`, r)
+			} else {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteRune(quote)
+
+	return b.String()
+}
@@ -0,0 +1,53 @@
+package processor_test
+
+import (
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func TestStringLiteralQuote(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		literal processor.StringLiteral
+		s       string
+		want    string
+	}{
+		{
+			name:    "json",
+			literal: processor.StringLiteral{},
+			s:       "a\"b\\c\n\t\x01'$<&\u2028é",
+			want:    `"a\"b\\c\n\t\u0001'$<&\u2028é"`,
+		},
+		{
+			name:    "interpolation",
+			literal: processor.StringLiteral{Interpolation: "$"},
+			s:       "${id} costs $5",
+			want:    `"\${id} costs \$5"`,
+		},
+		{
+			name:    "braced unicode",
+			literal: processor.StringLiteral{BracedUnicode: true},
+			s:       "a\x01\x7f\r",
+			want:    `"a\u{1}\u{7f}\r"`,
+		},
+		{
+			name:    "single quoted",
+			literal: processor.StringLiteral{Interpolation: "$", SingleQuoted: true},
+			s:       `it's "$x"`,
+			want:    `'it\'s "\$x"'`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.literal.Quote(tc.s); got != tc.want {
+				t.Errorf("Quote(%q) = %s, want %s", tc.s, got, tc.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package processor
 
 import (
 	"fmt"
+	"maps"
 	"net/http"
 	"slices"
 	"strconv"
@@ -21,6 +22,7 @@ type Method struct {
 	name       string
 	method     string
 	path       string
+	segments   []*PathSegment
 	Operation  *v3.Operation
 	Parameters []*Parameter
 	// key is the media type (e.g., "application/json")
@@ -29,19 +31,36 @@ type Method struct {
 	// first key is the response code (e.g., "200")
 	// second key is the media type (e.g., "application/json")
 	Responses map[string]map[string]Type
-	p         Plugin
+	// key is the media type, nil if the operation has no default response
+	DefaultResponse map[string]Type
+	// key is the response code (e.g., "200")
+	ResponseHeaders map[string][]*ResponseHeader
+	p               Plugin
 }
 
 func (m *Method) Name() string {
 	return m.p.MethodName(m.name)
 }
 
+func (m *Method) Deprecated() bool {
+	return m.Operation.Deprecated != nil && *m.Operation.Deprecated
+}
+
+func (m *Method) DeprecationMessage() string {
+	return deprecationMessage(m.Operation.Extensions)
+}
+
 func (m *Method) Method() string {
 	return strings.ToUpper(m.method)
 }
 
 func (m *Method) Path() string {
-	return m.p.MethodPath(m.path)
+	return m.p.MethodPath(m.segments)
+}
+
+// PathSegments returns the parsed path template of the method.
+func (m *Method) PathSegments() []*PathSegment {
+	return m.segments
 }
 
 func (m *Method) PathParameters() []*Parameter {
@@ -76,6 +95,17 @@ func (m *Method) QueryParameters() []*Parameter {
 	return params
 }
 
+func (m *Method) HeaderParameters() []*Parameter {
+	params := make([]*Parameter, 0, 10) //nolint:mnd
+	for _, param := range m.Parameters {
+		if param.Parameter.In == "header" {
+			params = append(params, param)
+		}
+	}
+
+	return params
+}
+
 func (m *Method) QueryParametersTypeName() string {
 	return m.p.TypeObjectName(m.Name() + "Parameters")
 }
@@ -88,18 +118,27 @@ func addIfNotPresent[S ~[]E, E comparable](s S, v E) S { //nolint:ireturn
 	return s
 }
 
-func (m *Method) ReturnType() string {
-	tt := make([]string, 0, 10) //nolint:mnd
+// successCodes returns the response codes below 300 sorted so the generated
+// code doesn't depend on map iteration order. Ranges (2XX) and default are ignored.
+func (m *Method) successCodes() []string {
+	codes := make([]string, 0, len(m.Responses))
 
-	for c, resp := range m.Responses {
-		code, err := strconv.Atoi(c)
-		if err != nil {
-			panic(fmt.Sprintf("invalid response code %s: %v", c, err))
+	for c := range m.Responses {
+		if code, err := strconv.Atoi(c); err == nil && code < minStatusForError {
+			codes = append(codes, c)
 		}
+	}
 
-		if code >= minStatusForError {
-			continue
-		}
+	slices.Sort(codes)
+
+	return codes
+}
+
+func (m *Method) ReturnType() string {
+	tt := make([]string, 0, 10) //nolint:mnd
+
+	for _, code := range m.successCodes() {
+		resp := m.Responses[code]
 
 		if len(resp) == 0 {
 			tt = addIfNotPresent(tt, "void")
@@ -118,6 +157,117 @@ func (m *Method) ReturnType() string {
 	return strings.Join(tt, " | ")
 }
 
+// SuccessResponse is a successful response of a method for a given media type.
+type SuccessResponse struct {
+	Code string
+	// MediaType is empty if the response has no body
+	MediaType string
+	// Type is nil unless the body is JSON
+	Type Type
+}
+
+// SuccessResponses returns the successful responses of the method sorted by
+// response code and media type.
+func (m *Method) SuccessResponses() []*SuccessResponse {
+	responses := make([]*SuccessResponse, 0, len(m.Responses))
+
+	for _, code := range m.successCodes() {
+		if len(m.Responses[code]) == 0 {
+			responses = append(responses, &SuccessResponse{Code: code, MediaType: "", Type: nil})
+			continue
+		}
+
+		for _, media := range slices.Sorted(maps.Keys(m.Responses[code])) {
+			var t Type
+			if media == mediaApplicationJSON {
+				t = m.Responses[code][media]
+			}
+
+			responses = append(responses, &SuccessResponse{Code: code, MediaType: media, Type: t})
+		}
+	}
+
+	return responses
+}
+
+// SuccessResponsesByCode returns the successful responses of the method with a
+// single entry per response code, the first media type in alphabetical order.
+func (m *Method) SuccessResponsesByCode() []*SuccessResponse {
+	responses := make([]*SuccessResponse, 0, len(m.Responses))
+	for _, r := range m.SuccessResponses() {
+		if len(responses) == 0 || responses[len(responses)-1].Code != r.Code {
+			responses = append(responses, r)
+		}
+	}
+
+	return responses
+}
+
+// ErrorResponse is a response of a method with a status code of 300 or more.
+type ErrorResponse struct {
+	Code string
+	// Type is nil unless the body is JSON
+	Type Type
+}
+
+// ErrorResponses returns the error responses of the method documented with a
+// status code sorted by code. Ranges (4XX) and default are ignored.
+func (m *Method) ErrorResponses() []*ErrorResponse {
+	codes := make([]string, 0, len(m.Responses))
+
+	for c := range m.Responses {
+		if code, err := strconv.Atoi(c); err == nil && code >= minStatusForError {
+			codes = append(codes, c)
+		}
+	}
+
+	slices.Sort(codes)
+
+	responses := make([]*ErrorResponse, len(codes))
+	for i, code := range codes {
+		responses[i] = &ErrorResponse{Code: code, Type: m.Responses[code][mediaApplicationJSON]}
+	}
+
+	return responses
+}
+
+// DefaultResponseJSONType returns the type of the body of the default response if
+// it's JSON, nil otherwise.
+func (m *Method) DefaultResponseJSONType() Type { //nolint:ireturn
+	return m.DefaultResponse[mediaApplicationJSON]
+}
+
+// ErrorTypes names the types the bodies of error responses are decoded into for
+// languages decoding bodies without a schema, or that aren't JSON, into Fallback.
+type ErrorTypes struct {
+	Fallback string
+}
+
+// Type returns the name of t or the fallback if t is nil.
+func (e ErrorTypes) Type(t Type) string {
+	if t != nil {
+		return t.Name()
+	}
+
+	return e.Fallback
+}
+
+// Default returns the type the body of undocumented error responses of m is
+// decoded into.
+func (e ErrorTypes) Default(m *Method) string {
+	return e.Type(m.DefaultResponseJSONType())
+}
+
+// Responses returns the error responses of m documented with a status code whose
+// body isn't decoded as the default one, sorted by code.
+func (e ErrorTypes) Responses(m *Method) []*ErrorResponse {
+	fallback := e.Default(m)
+
+	return slices.DeleteFunc(m.ErrorResponses(), func(r *ErrorResponse) bool {
+		return e.Type(r.Type) == fallback
+	})
+}
+
 func (m *Method) RequestJSON() Type { //nolint:ireturn
 	for m, t := range m.Bodies {
 		if m == mediaApplicationJSON {
@@ -138,22 +288,25 @@ func (m *Method) RequestFormData() Type { //nolint:ireturn
 	return nil
 }
 
+// RawBodyMediaType returns the media type of the request body of m if it isn't
+// JSON or multipart, in which case the body is sent as is.
+func (m *Method) RawBodyMediaType() string {
+	for _, media := range slices.Sorted(maps.Keys(m.Bodies)) {
+		if media != mediaApplicationJSON && media != "multipart/form-data" {
+			return media
+		}
+	}
+
+	return ""
+}
+
 func (m *Method) RequestHasBody() bool {
 	return len(m.Bodies) > 0
 }
 
 func (m *Method) ResponseJSON() bool {
-	for c, resp := range m.Responses {
-		code, err := strconv.Atoi(c)
-		if err != nil {
-			panic(fmt.Sprintf("invalid response code %s: %v", c, err))
-		}
-
-		if code >= minStatusForError {
-			continue
-		}
-
-		for media := range resp {
+	for _, code := range m.successCodes() {
+		for media := range m.Responses[code] {
 			return media == mediaApplicationJSON
 		}
 	}
@@ -162,17 +315,8 @@ func (m *Method) ResponseJSON() bool {
 }
 
 func (m *Method) ResponseBinary() bool {
-	for c, resp := range m.Responses {
-		code, err := strconv.Atoi(c)
-		if err != nil {
-			panic(fmt.Sprintf("invalid response code %s: %v", c, err))
-		}
-
-		if code >= minStatusForError {
-			continue
-		}
-
-		for media := range resp {
+	for _, code := range m.successCodes() {
+		for media := range m.Responses[code] {
 			return media == mediaApplicationOctetStream
 		}
 	}
@@ -189,22 +333,26 @@ func (m *Method) IsRedirect() bool {
 }
 
 func (m *Method) HasResponseBody() bool {
-	for c, resp := range m.Responses {
-		code, err := strconv.Atoi(c)
-		if err != nil {
-			panic(fmt.Sprintf("invalid response code %s: %v", c, err))
-		}
-
-		if code >= minStatusForError {
-			continue
-		}
-
-		return len(resp) > 0
+	codes := m.successCodes()
+	if len(codes) == 0 {
+		return false
 	}
 
-	return false
+	return len(m.Responses[codes[0]]) > 0
 }
 
+type ParameterStyle string
+
+const (
+	ParameterStyleMatrix         ParameterStyle = "matrix"
+	ParameterStyleLabel          ParameterStyle = "label"
+	ParameterStyleForm           ParameterStyle = "form"
+	ParameterStyleSimple         ParameterStyle = "simple"
+	ParameterStyleSpaceDelimited ParameterStyle = "spaceDelimited"
+	ParameterStylePipeDelimited  ParameterStyle = "pipeDelimited"
+	ParameterStyleDeepObject     ParameterStyle = "deepObject"
+)
+
 type Parameter struct {
 	name      string
 	Parameter *v3.Parameter
@@ -216,6 +364,59 @@ func (p *Parameter) Name() string {
 	return p.p.ParameterName(p.name)
 }
 
+// WireName returns the name of the parameter as sent to the server.
+func (p *Parameter) WireName() string {
+	return p.name
+}
+
+// Style returns how the parameter is serialized, applying the OpenAPI defaults
+// (form for query and cookie parameters, simple for path and header parameters).
+func (p *Parameter) Style() ParameterStyle {
+	if p.Parameter.Style != "" {
+		return ParameterStyle(p.Parameter.Style)
+	}
+
+	switch p.Parameter.In {
+	case "query", "cookie":
+		return ParameterStyleForm
+	default:
+		return ParameterStyleSimple
+	}
+}
+
+// Explode returns whether arrays and objects generate separate parameters for
+// each value. Defaults to true for the form style and false otherwise.
+func (p *Parameter) Explode() bool {
+	if p.Parameter.Explode != nil {
+		return *p.Parameter.Explode
+	}
+
+	return p.Style() == ParameterStyleForm
+}
+
+// AllowReserved returns whether reserved characters are sent without percent-encoding.
+func (p *Parameter) AllowReserved() bool {
+	return p.Parameter.AllowReserved
+}
+
+// IsContent returns true if the parameter is serialized using a media type
+// (the content field) instead of a style.
+func (p *Parameter) IsContent() bool {
+	return p.Parameter.Schema == nil && p.Parameter.Content != nil
+}
+
+func (p *Parameter) Deprecated() bool {
+	return p.Parameter.Deprecated || schemaDeprecated(p.Parameter.Schema)
+}
+
+func (p *Parameter) DeprecationMessage() string {
+	if msg := deprecationMessage(p.Parameter.Extensions); msg != "" {
+		return msg
+	}
+
+	return schemaDeprecationMessage(p.Parameter.Schema)
+}
+
 func (p *Parameter) Required() bool {
 	if p.Parameter.Required != nil {
 		return *p.Parameter.Required
@@ -228,9 +429,13 @@ func (p *Parameter) Required() bool {
 	return false
 }
 
+// GetMethod returns the method for the operation along with the types it declares.
+// shared are the parameters of the path item, which apply to every operation of
+// the path unless the operation declares a parameter with the same name and location.
 func GetMethod(
 	path string,
 	method string,
+	shared []*v3.Parameter,
 	operation *v3.Operation,
 	p Plugin,
 ) (*Method, []Type, error) {
@@ -244,7 +449,7 @@ func GetMethod(
 			)
 	}
 
-	params, types, err := getMethodParameters(method, operation, p)
+	params, types, err := getMethodParameters(method, shared, operation, p)
 	if err != nil {
 		return nil, nil, fmt.Errorf(
 			"failed to get method parameters for %s: %w",
@@ -253,6 +458,11 @@ func GetMethod(
 		)
 	}
 
+	segments, err := parsePath(path, params)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse path for %s: %w", operation.OperationId, err)
+	}
+
 	bodies, tt, err := getMethodBodies(operation, p)
 	if err != nil {
 		return nil, nil,
@@ -261,7 +471,7 @@ func GetMethod(
 
 	types = append(types, tt...)
 
-	responses, tt, err := getMethodResponses(operation, p)
+	responses, headers, tt, err := getMethodResponses(operation, p)
 	if err != nil {
 		return nil, nil,
 			fmt.Errorf("failed to get method responses for %s: %w", operation.OperationId, err)
@@ -269,36 +479,66 @@ func GetMethod(
 
 	types = append(types, tt...)
 
+	defaultResponse, tt, err := getMethodDefaultResponse(operation, p)
+	if err != nil {
+		return nil, nil,
+			fmt.Errorf("failed to get default response for %s: %w", operation.OperationId, err)
+	}
+
+	types = append(types, tt...)
+
 	return &Method{
 		name:       operation.OperationId,
 		method:     method,
 		path:       path,
+		segments:   segments,
 		Parameters: params,
 		Bodies:     bodies,
 		Operation:  operation,
 		BodyRequired: operation.RequestBody != nil && operation.RequestBody.Required != nil &&
 			*operation.RequestBody.Required,
-		Responses: responses,
-		p:         p,
+		Responses:       responses,
+		DefaultResponse: defaultResponse,
+		ResponseHeaders: headers,
+		p:               p,
 	}, types, nil
 }
 
+// operationParameters returns the parameters of the path item not overridden by
+// the operation, followed by the parameters of the operation.
+func operationParameters(shared []*v3.Parameter, operation *v3.Operation) []*v3.Parameter {
+	params := make([]*v3.Parameter, 0, len(shared)+len(operation.Parameters))
+
+	for _, param := range shared {
+		if !slices.ContainsFunc(operation.Parameters, func(p *v3.Parameter) bool {
+			return p.Name == param.Name && p.In == param.In
+		}) {
+			params = append(params, param)
+		}
+	}
+
+	return append(params, operation.Parameters...)
+}
+
 func getMethodParameters(
 	method string,
+	shared []*v3.Parameter,
 	operation *v3.Operation,
 	p Plugin,
 ) ([]*Parameter, []Type, error) {
-	params := make([]*Parameter, len(operation.Parameters))
+	parameters := operationParameters(shared, operation)
+	params := make([]*Parameter, len(parameters))
 	types := make([]Type, 0, 10) //nolint:mnd
 
-	for i, param := range operation.Parameters {
+	for i, param := range parameters {
 		var t Type
 		if param.GoLow().IsReference() {
 			t = &TypeEnum{
-				schema: param.Schema,
-				name:   format.GetNameFromComponentRef(param.GoLow().GetReference()),
-				values: nil, // No values for reference types
-				p:      p,
+				schema:    param.Schema,
+				name:      format.GetNameFromComponentRef(param.GoLow().GetReference()),
+				values:    nil, // No values for reference types
+				component: true,
+				p:         p,
 			}
 		} else {
 			switch {
@@ -398,55 +638,90 @@ func getMethodBodies(
 func getMethodResponses(
 	operation *v3.Operation,
 	p Plugin,
-) (map[string]map[string]Type, []Type, error) {
+) (map[string]map[string]Type, map[string][]*ResponseHeader, []Type, error) {
 	responses := make(map[string]map[string]Type)
+	headers := make(map[string][]*ResponseHeader)
 	types := make([]Type, 0, 10) //nolint:mnd
 
 	for pcodes := operation.Responses.Codes.First(); pcodes != nil; pcodes = pcodes.Next() {
 		code := pcodes.Key()
 		response := pcodes.Value()
 
-		responses[code] = make(map[string]Type)
-
-		if response == nil || response.Content == nil {
-			continue
+		h, tt, err := getResponseHeaders(operation, response, p)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to get headers for response %s: %w", code, err)
 		}
 
-		pcontent := response.Content.First()
-		if pcontent == nil {
-			continue
+		if len(h) > 0 {
+			headers[code] = h
 		}
 
-		if pcontent.Next() != nil {
-			return nil, nil, fmt.Errorf(
-				"%w: operation %s has multiple response bodies for code %s",
-				ErrUnsupportedFeature, operation.OperationId, code)
+		types = append(types, tt...)
+
+		body, tt, err := getResponseBody(operation, code, response, p)
+		if err != nil {
+			return nil, nil, nil, err
 		}
 
-		mediaType := pcontent.Key()
-		proxy := pcontent.Value()
+		responses[code] = body
+		types = append(types, tt...)
+	}
 
-		// some types may not have a schema defined, e.g., for 204 No Content responses or binary responses
-		if proxy.Schema == nil {
-			responses[code][mediaType] = nil
-			continue
-		}
+	return responses, headers, types, nil
+}
 
-		name := operation.OperationId + "Response" + code
+// getMethodDefaultResponse returns the body of the default response of the
+// operation, nil if it doesn't document one.
+func getMethodDefaultResponse(operation *v3.Operation, p Plugin) (map[string]Type, []Type, error) {
+	if operation.Responses == nil || operation.Responses.Default == nil {
+		return nil, nil, nil
+	}
 
-		t, tt, err := GetType(proxy.Schema, name, p, false)
-		if err != nil {
-			return nil, nil, fmt.Errorf(
-				"failed to get type for response with media type %s: %w",
-				mediaType,
-				err,
-			)
-		}
+	return getResponseBody(operation, "default", operation.Responses.Default, p)
+}
 
-		responses[code][mediaType] = t
+// getResponseBody returns the type of the body of the response keyed by media type.
+func getResponseBody(
+	operation *v3.Operation, code string, response *v3.Response, p Plugin,
+) (map[string]Type, []Type, error) {
+	body := make(map[string]Type)
 
-		types = append(types, tt...)
+	if response == nil || response.Content == nil {
+		return body, nil, nil
 	}
 
-	return responses, types, nil
+	pcontent := response.Content.First()
+	if pcontent == nil {
+		return body, nil, nil
+	}
+
+	if pcontent.Next() != nil {
+		return nil, nil, fmt.Errorf(
+			"%w: operation %s has multiple response bodies for code %s",
+			ErrUnsupportedFeature, operation.OperationId, code)
+	}
+
+	mediaType := pcontent.Key()
+	proxy := pcontent.Value()
+
+	// some types may not have a schema defined, e.g., for 204 No Content responses or binary responses
+	if proxy.Schema == nil {
+		body[mediaType] = nil
+		return body, nil, nil
+	}
+
+	name := operation.OperationId + "Response" + format.Title(code)
+
+	t, tt, err := GetType(proxy.Schema, name, p, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to get type for response with media type %s: %w",
+			mediaType,
+			err,
+		)
+	}
+
+	body[mediaType] = t
+
+	return body, tt, nil
 }
@@ -0,0 +1,240 @@
+package processor_test
+
+import (
+	"bytes"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameterStyle(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/query_styles.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	cases := []struct {
+		name          string
+		style         processor.ParameterStyle
+		explode       bool
+		allowReserved bool
+	}{
+		{name: "tags", style: processor.ParameterStyleForm, explode: true, allowReserved: false},
+		{name: "ids", style: processor.ParameterStyleForm, explode: false, allowReserved: false},
+		{
+			name:          "buckets",
+			style:         processor.ParameterStyleSpaceDelimited,
+			explode:       false,
+			allowReserved: false,
+		},
+		{
+			name:          "mimeTypes",
+			style:         processor.ParameterStylePipeDelimited,
+			explode:       false,
+			allowReserved: false,
+		},
+		{
+			name:          "filter",
+			style:         processor.ParameterStyleDeepObject,
+			explode:       true,
+			allowReserved: false,
+		},
+		{name: "metadata", style: processor.ParameterStyleForm, explode: true, allowReserved: false},
+		{name: "sort", style: processor.ParameterStyleForm, explode: false, allowReserved: false},
+		{name: "redirectTo", style: processor.ParameterStyleForm, explode: true, allowReserved: true},
+		{
+			name:          "x-request-id",
+			style:         processor.ParameterStyleSimple,
+			explode:       false,
+			allowReserved: false,
+		},
+	}
+
+	params := ir.Methods[0].Parameters
+	if len(params) != len(cases) {
+		t.Fatalf("expected %d parameters, got %d", len(cases), len(params))
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			param := params[i]
+			assert.Equal(t, tc.name, param.WireName())
+			assert.Equal(t, tc.style, param.Style())
+			assert.Equal(t, tc.explode, param.Explode())
+			assert.Equal(t, tc.allowReserved, param.AllowReserved())
+		})
+	}
+}
+
+func TestErrorResponses(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/methods_ref.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{}) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	methods := make(map[string]*processor.Method, len(ir.Methods))
+	for _, m := range ir.Methods {
+		methods[m.Operation.OperationId] = m
+	}
+
+	refreshToken := methods["refreshToken"]
+	assert.Empty(t, refreshToken.ErrorResponses())
+	assert.Equal(t, "ErrorResponse", refreshToken.DefaultResponseJSONType().Name())
+
+	deleteFile := methods["deleteFile"]
+	assert.Nil(t, deleteFile.DefaultResponseJSONType())
+
+	responses := deleteFile.ErrorResponses()
+	if len(responses) != 1 {
+		t.Fatalf("expected 1 error response, got %d", len(responses))
+	}
+
+	assert.Equal(t, "400", responses[0].Code)
+	assert.Equal(t, "ErrorResponse", responses[0].Type.Name())
+
+	// redirects are not successful responses
+	responses = methods["verifyTicket"].ErrorResponses()
+	if len(responses) != 1 {
+		t.Fatalf("expected 1 error response, got %d", len(responses))
+	}
+
+	assert.Equal(t, "302", responses[0].Code)
+	assert.Nil(t, responses[0].Type)
+
+	errorTypes := processor.ErrorTypes{Fallback: "JsonElement"}
+	assert.Equal(t, "JsonElement", errorTypes.Default(deleteFile))
+	assert.Equal(t, "ErrorResponse", errorTypes.Default(refreshToken))
+	assert.Len(t, errorTypes.Responses(deleteFile), 1)
+	// the redirect has no body and is decoded as the default response
+	assert.Empty(t, errorTypes.Responses(methods["verifyTicket"]))
+}
+
+func TestSuccessResponses(t *testing.T) {
+	t.Parallel()
+
+	// ranges and default are ignored and the codes are sorted, so the generated
+	// code doesn't depend on the iteration order of the map
+	m := &processor.Method{ //nolint:exhaustruct
+		Responses: map[string]map[string]processor.Type{
+			"2XX":     {"text/plain": nil},
+			"default": {"application/json": nil},
+			"204":     {},
+			"201":     {"application/octet-stream": nil},
+			"200":     {"application/json": nil},
+			"400":     {"application/json": nil},
+		},
+	}
+
+	codes := make([]string, 0)
+	for _, r := range m.SuccessResponses() {
+		codes = append(codes, r.Code)
+	}
+
+	assert.Equal(t, []string{"200", "201", "204"}, codes)
+	assert.True(t, m.ResponseJSON())
+	assert.False(t, m.ResponseBinary())
+	assert.True(t, m.HasResponseBody())
+}
+
+func TestRawBodyMediaType(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		bodies map[string]processor.Type
+		want   string
+	}{
+		{
+			name:   "no body",
+			bodies: map[string]processor.Type{},
+			want:   "",
+		},
+		{
+			name: "json and multipart",
+			bodies: map[string]processor.Type{
+				"application/json":    nil,
+				"multipart/form-data": nil,
+			},
+			want: "",
+		},
+		{
+			name: "raw",
+			bodies: map[string]processor.Type{
+				"application/json": nil,
+				"text/plain":       nil,
+				"application/xml":  nil,
+			},
+			want: "application/xml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &processor.Method{Bodies: tc.bodies} //nolint:exhaustruct
+			assert.Equal(t, tc.want, m.RawBodyMediaType())
+		})
+	}
+}
+
+func TestEncodeReserved(t *testing.T) {
+	t.Parallel()
+
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node is required to run the generated code")
+	}
+
+	doc, err := getModel("testdata/query_styles.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := ir.Render(&b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+
+	// the body of the helper is plain JavaScript
+	match := regexp.MustCompile(`const encodeReserved = \(value: string\): string =>\s+(.+);`).
+		FindStringSubmatch(b.String())
+	if match == nil {
+		t.Fatal("encodeReserved helper not found")
+	}
+
+	script := "const value = process.argv[1]; console.log(" + match[1] + ");"
+
+	out, err := exec.Command(node, "-e", script, "https://a.io/cb?x=1&y=2#top +$,;:@").Output() //nolint:gosec
+	if err != nil {
+		t.Fatalf("failed to run node: %v", err)
+	}
+
+	assert.Equal(t, "https://a.io/cb?x%3D1%26y%3D2%23top%20%2B$,;:@", strings.TrimSpace(string(out)))
+}
@@ -0,0 +1,269 @@
+package msw
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/mock"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+const (
+	mediaApplicationJSON = "application/json"
+	mediaFormData        = "multipart/form-data"
+)
+
+var nonIdentifier = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func marshal(v any, indent string) string {
+	var b bytes.Buffer
+
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", indent)
+
+	if err := enc.Encode(v); err != nil {
+		panic(fmt.Sprintf("failed to marshal %v: %v", v, err))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// jsString returns s as a JavaScript string literal.
+//
+//nolint:gochecknoglobals
+var jsString = processor.StringLiteral{Interpolation: "", BracedUnicode: false, SingleQuoted: false}.Quote
+
+// paramKey returns the name of the path parameter in the MSW path. Path-to-regexp
+// only accepts word characters.
+func paramKey(name string) string {
+	return nonIdentifier.ReplaceAllString(name, "_")
+}
+
+// namedParameters returns the path parameters that can be matched by name. MSW
+// only understands the simple style when the value is followed by a literal.
+func namedParameters(m *processor.Method) map[*processor.Parameter]bool {
+	named := make(map[*processor.Parameter]bool)
+	segments := m.PathSegments()
+
+	for i, segment := range segments {
+		if !segment.IsParameter() || segment.Parameter.Style() != processor.ParameterStyleSimple {
+			continue
+		}
+
+		if i+1 == len(segments) || !segments[i+1].IsParameter() {
+			named[segment.Parameter] = true
+		}
+	}
+
+	return named
+}
+
+// handlerPath returns the path matched by the handler, relative to the base URL.
+// Parameters that can't be matched by name are matched by a single wildcard.
+func handlerPath(m *processor.Method) string {
+	named := namedParameters(m)
+
+	var b strings.Builder
+
+	for _, segment := range m.PathSegments() {
+		switch {
+		case !segment.IsParameter():
+			b.WriteString(strings.NewReplacer("\\", "\\\\", "`", "\\`", "${", "\\${").Replace(segment.Literal))
+		case named[segment.Parameter]:
+			b.WriteString(":" + paramKey(segment.Parameter.WireName()))
+		case !strings.HasSuffix(b.String(), "*"):
+			b.WriteString("*")
+		}
+	}
+
+	return b.String()
+}
+
+func paramsType(m *processor.Method) string {
+	named := namedParameters(m)
+
+	fields := make([]string, 0, len(named))
+
+	for _, param := range m.PathParameters() {
+		if named[param] {
+			fields = append(fields, paramKey(param.WireName())+": string")
+		}
+	}
+
+	if len(fields) == 0 {
+		return "Record<string, never>"
+	}
+
+	return "{ " + strings.Join(fields, "; ") + " }"
+}
+
+func bodyMediaType(m *processor.Method) string {
+	for mediaType := range m.Bodies {
+		return mediaType
+	}
+
+	return ""
+}
+
+func bodyType(m *processor.Method) string {
+	var t string
+
+	switch mediaType := bodyMediaType(m); mediaType {
+	case "":
+		return "undefined"
+	case mediaApplicationJSON:
+		t = m.Bodies[mediaType].Name()
+	case mediaFormData:
+		t = "FormData"
+	default:
+		t = "Blob"
+	}
+
+	if !m.BodyRequired {
+		t += " | undefined"
+	}
+
+	return t
+}
+
+func bodyExpression(m *processor.Method) string {
+	switch bodyMediaType(m) {
+	case "":
+		return "undefined"
+	case mediaApplicationJSON:
+		return fmt.Sprintf("(await readJSONBody(request)) as %s", bodyType(m))
+	case mediaFormData:
+		return "await request.clone().formData()"
+	default:
+		return "await request.clone().blob()"
+	}
+}
+
+// response returns the media type and type of the default response of the method.
+func response(m *processor.Method) (string, processor.Type) { //nolint:ireturn
+	for mediaType, t := range m.Responses[strconv.Itoa(mock.DefaultStatus(m))] {
+		return mediaType, t
+	}
+
+	return "", nil
+}
+
+func responseType(m *processor.Method) string {
+	mediaType, t := response(m)
+
+	switch {
+	case mediaType == "":
+		return "void"
+	case mediaType == mediaApplicationJSON && t == nil:
+		return "unknown"
+	case mediaType == mediaApplicationJSON:
+		return t.Name()
+	case t != nil && processor.ScalarType(t) == "string" &&
+		processor.GetConstraints(t).Format != "binary":
+		return "string"
+	default:
+		return "Blob"
+	}
+}
+
+// responseInit returns the status, media type and headers used to answer with the
+// value returned by the resolver.
+func responseInit(m *processor.Method) string {
+	ex := mock.ResponseExample(m, mock.DefaultStatus(m))
+
+	fields := []string{"status: " + strconv.Itoa(ex.Status)}
+
+	if ex.MediaType != "" {
+		fields = append(fields, "mediaType: "+jsString(ex.MediaType))
+	}
+
+	if len(ex.Headers) > 0 {
+		names := make([]string, 0, len(ex.Headers))
+		for name := range ex.Headers {
+			names = append(names, name)
+		}
+
+		slices.Sort(names)
+
+		headers := make([]string, len(names))
+		for i, name := range names {
+			headers[i] = jsString(name) + ": " + jsString(ex.Headers[name])
+		}
+
+		fields = append(fields, "headers: { "+strings.Join(headers, ", ")+" }")
+	}
+
+	return "{ " + strings.Join(fields, ", ") + " }"
+}
+
+// responseExample returns the TypeScript literal of the example of a JSON response, or
+// an empty string if the method doesn't respond with JSON.
+func responseExample(m *processor.Method) string {
+	ex := mock.ResponseExample(m, mock.DefaultStatus(m))
+	if ex.MediaType != mediaApplicationJSON || !ex.HasBody || responseType(m) == "unknown" {
+		return ""
+	}
+
+	return marshal(ex.Body, "  ")
+}
+
+// defaultResult returns the expression returned by the default resolver of the method.
+func defaultResult(m *processor.Method) string {
+	if responseExample(m) != "" {
+		return m.Name() + "Example"
+	}
+
+	ex := mock.ResponseExample(m, mock.DefaultStatus(m))
+	s, isString := ex.Body.(string)
+
+	switch responseType(m) {
+	case "void", "unknown":
+		return "undefined"
+	case "string":
+		return jsString(s)
+	default:
+		if isString && s != "" {
+			return fmt.Sprintf("new Blob([%s])", jsString(s))
+		}
+
+		return "new Blob()"
+	}
+}
+
+func namedTypes(t processor.Type, names []string) []string {
+	switch t := t.(type) {
+	case *processor.TypeObject, *processor.TypeEnum, *processor.TypeAlias:
+		if !slices.Contains(names, t.Name()) {
+			names = append(names, t.Name())
+		}
+	case *processor.TypeArray:
+		return namedTypes(t.Item, names)
+	}
+
+	return names
+}
+
+// imports returns the types of the client referenced by the handlers.
+func imports(methods []*processor.Method) []string {
+	names := make([]string, 0, len(methods))
+
+	for _, m := range methods {
+		if t := m.RequestJSON(); t != nil {
+			names = namedTypes(t, names)
+		}
+
+		if mediaType, t := response(m); mediaType == mediaApplicationJSON && t != nil {
+			names = namedTypes(t, names)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
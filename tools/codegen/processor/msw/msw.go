@@ -0,0 +1,39 @@
+package msw
+
+import (
+	"embed"
+	"io/fs"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// MSW generates Mock Service Worker request handlers for the methods of the
+// intermediate representation. Types are imported from the client generated by
+// the typescript plugin in the same directory, so naming is delegated to it.
+type MSW struct {
+	typescript.Typescript
+}
+
+func (m *MSW) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (m *MSW) GetFuncMap() map[string]any {
+	return map[string]any{
+		"jsString":        jsString,
+		"imports":         imports,
+		"handlerPath":     handlerPath,
+		"paramsType":      paramsType,
+		"bodyType":        bodyType,
+		"bodyExpression":  bodyExpression,
+		"responseType":    responseType,
+		"responseInit":    responseInit,
+		"responseExample": responseExample,
+		"defaultResult":   defaultResult,
+		"lower":           strings.ToLower,
+	}
+}
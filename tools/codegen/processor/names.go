@@ -0,0 +1,87 @@
+package processor
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// DocumentNames is a plugin naming types, methods, parameters and properties as
+// in the OpenAPI document. It has no templates and is meant for commands working
+// on the intermediate representation without generating code, e.g. mock servers.
+type DocumentNames struct{}
+
+//nolint:gochecknoglobals
+var noTemplates embed.FS
+
+func (d *DocumentNames) GetTemplates() fs.FS {
+	return noTemplates
+}
+
+func (d *DocumentNames) GetFuncMap() map[string]any {
+	return map[string]any{}
+}
+
+func (d *DocumentNames) TypeObjectName(name string) string {
+	return name
+}
+
+func (d *DocumentNames) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (d *DocumentNames) TypeScalarName(scalar *TypeScalar) string {
+	return ScalarType(scalar)
+}
+
+func (d *DocumentNames) TypeArrayName(array *TypeArray) string {
+	return array.Item.Name() + "[]"
+}
+
+func (d *DocumentNames) TypeEnumName(name string) string {
+	return name
+}
+
+func (d *DocumentNames) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+	for i, v := range values {
+		enumValues[i] = fmt.Sprint(v)
+	}
+
+	return enumValues
+}
+
+func (d *DocumentNames) TypeMapName(_ *TypeMap) string {
+	return "object"
+}
+
+func (d *DocumentNames) MethodName(name string) string {
+	return name
+}
+
+func (d *DocumentNames) MethodPath(segments []*PathSegment) string {
+	var b strings.Builder
+
+	for _, segment := range segments {
+		if segment.IsParameter() {
+			b.WriteString("{" + segment.Parameter.WireName() + "}")
+		} else {
+			b.WriteString(segment.Literal)
+		}
+	}
+
+	return b.String()
+}
+
+func (d *DocumentNames) ParameterName(name string) string {
+	return name
+}
+
+func (d *DocumentNames) PropertyName(name string) string {
+	return name
+}
+
+func (d *DocumentNames) BinaryType() string {
+	return "string"
+}
@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PathSegment is a part of a path template. It is either a literal string
+// or a reference to a path parameter (e.g. {id} in /files/{id}).
+type PathSegment struct {
+	Literal   string
+	Parameter *Parameter
+}
+
+func (s *PathSegment) IsParameter() bool {
+	return s.Parameter != nil
+}
+
+// parsePath splits an OpenAPI path template into literal and parameter segments.
+// Every template expression must match one of the path parameters of the operation.
+func parsePath(path string, params []*Parameter) ([]*PathSegment, error) {
+	segments := make([]*PathSegment, 0, 4) //nolint:mnd
+	rest := path
+
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start == -1 {
+			segments = append(segments, &PathSegment{Literal: rest, Parameter: nil})
+			break
+		}
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end == -1 {
+			return nil, fmt.Errorf("%w: unclosed template expression in path %s", ErrInvalidPath, path)
+		}
+
+		end += start
+
+		if start > 0 {
+			segments = append(segments, &PathSegment{Literal: rest[:start], Parameter: nil})
+		}
+
+		name := rest[start+1 : end]
+
+		param := findPathParameter(params, name)
+		if param == nil {
+			return nil, fmt.Errorf(
+				"%w: path %s references parameter %s which is not defined",
+				ErrInvalidPath, path, name,
+			)
+		}
+
+		segments = append(segments, &PathSegment{Literal: "", Parameter: param})
+		rest = rest[end+1:]
+	}
+
+	return segments, nil
+}
+
+func findPathParameter(params []*Parameter, name string) *Parameter {
+	for _, param := range params {
+		if param.Parameter.In == "path" && param.name == name {
+			return param
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package processor_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethodPathSegments(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/path_styles.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	type segment struct {
+		literal   string
+		parameter string
+	}
+
+	want := []segment{
+		{literal: "/buckets/", parameter: ""},
+		{literal: "", parameter: "bucket"},
+		{literal: "/files/", parameter: ""},
+		{literal: "", parameter: "name"},
+		{literal: "", parameter: "ext"},
+		{literal: "", parameter: "coords"},
+		{literal: "", parameter: "tags"},
+		{literal: "", parameter: "point"},
+	}
+
+	got := make([]segment, 0, len(want))
+	for _, s := range ir.Methods[0].PathSegments() {
+		if s.IsParameter() {
+			got = append(got, segment{literal: "", parameter: s.Parameter.WireName()})
+		} else {
+			got = append(got, segment{literal: s.Literal, parameter: ""})
+		}
+	}
+
+	assert.Equal(t, want, got)
+}
+
+func TestGetMethodUndefinedPathParameter(t *testing.T) {
+	t.Parallel()
+
+	operation := &v3.Operation{ //nolint:exhaustruct
+		OperationId: "getFile",
+		Responses: &v3.Responses{ //nolint:exhaustruct
+			Codes: orderedmap.New[string, *v3.Response](),
+		},
+	}
+
+	_, _, err := processor.GetMethod("/files/{id}", "get", nil, operation, &typescript.Typescript{})
+	if !errors.Is(err, processor.ErrInvalidPath) {
+		t.Fatalf("expected ErrInvalidPath, got %v", err)
+	}
+}
+
+func TestPathItemParameters(t *testing.T) {
+	t.Parallel()
+
+	doc, err := getModel("testdata/path_parameters.yaml")
+	if err != nil {
+		t.Fatalf("failed to get model: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	params := make(map[string][]string, len(ir.Methods))
+	for _, m := range ir.Methods {
+		for _, p := range m.Parameters {
+			params[m.Operation.OperationId] = append(
+				params[m.Operation.OperationId], p.WireName()+":"+p.Type.Name(),
+			)
+		}
+	}
+
+	assert.Equal(t, map[string][]string{
+		"getFile":    {"id:string", "version:number"},
+		"deleteFile": {"id:string", "version:string"},
+	}, params)
+}
@@ -0,0 +1,79 @@
+package processor
+
+import "slices"
+
+/*
+Every schema and parameter in the components of the document becomes a type, even
+if no operation uses it, e.g. after excluding operations by tag. When
+Options.Prune is set, types are only kept if they are reachable:
+
+- from the parameters, bodies, responses, default responses and response headers of
+  the methods,
+- or from the types listed in Options.Keep,
+
+following the properties of objects, the items of arrays and the aliased types.
+*/
+
+// prune returns the types reachable from the methods and the kept types, along
+// with the removed ones.
+func prune(types []Type, methods []*Method, keep []string) ([]Type, []Type) {
+	declarations := make(map[string]Type, len(types))
+
+	for _, t := range types {
+		if name, ok := typeName(t); ok {
+			if _, ok := declarations[name]; !ok {
+				declarations[name] = t
+			}
+		}
+	}
+
+	reachable := make(map[string]struct{}, len(types))
+
+	var visit func(t Type)
+	visit = func(t Type) {
+		if name, ok := typeName(t); ok {
+			if _, ok := reachable[name]; ok {
+				return
+			}
+
+			reachable[name] = struct{}{}
+
+			// references are separate instances of the declared type
+			if declared, ok := declarations[name]; ok {
+				t = declared
+			}
+		}
+
+		for _, child := range children(t) {
+			visit(child)
+		}
+	}
+
+	for _, m := range methods {
+		for _, t := range methodTypes(m) {
+			visit(t)
+		}
+	}
+
+	for _, t := range types {
+		name, ok := typeName(t)
+		if ok && (slices.Contains(keep, name) || slices.Contains(keep, t.Name())) {
+			visit(t)
+		}
+	}
+
+	kept := make([]Type, 0, len(types))
+	pruned := make([]Type, 0)
+
+	for _, t := range types {
+		name, ok := typeName(t)
+		if _, reached := reachable[name]; ok && !reached {
+			pruned = append(pruned, t)
+			continue
+		}
+
+		kept = append(kept, t)
+	}
+
+	return kept, pruned
+}
@@ -0,0 +1,56 @@
+package processor_test
+
+import (
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		keep   []string
+		types  []string
+		pruned []string
+	}{
+		{
+			name:   "unused",
+			keep:   nil,
+			types:  []string{"Pet", "Owner", "Tag", "ErrorResponse"},
+			pruned: []string{"AdminReport", "AdminEntry", "Slug"},
+		},
+		{
+			name:   "keep",
+			keep:   []string{"AdminReport", "Slug"},
+			types:  []string{"Pet", "Owner", "Tag", "AdminReport", "AdminEntry", "Slug", "ErrorResponse"},
+			pruned: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := getModel("testdata/prune.yaml")
+			if err != nil {
+				t.Fatalf("failed to get model: %v", err)
+			}
+
+			ir, err := processor.NewInterMediateRepresentationWithOptions(
+				doc,
+				&typescript.Typescript{}, //nolint:exhaustruct
+				processor.Options{RenameCollisions: false, Prune: true, Keep: tc.keep},
+			)
+			if err != nil {
+				t.Fatalf("failed to create intermediate representation: %v", err)
+			}
+
+			assert.Equal(t, tc.types, typeNames(ir.Types))
+			assert.Equal(t, tc.pruned, typeNames(ir.Pruned))
+		})
+	}
+}
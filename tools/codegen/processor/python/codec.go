@@ -0,0 +1,227 @@
+package python
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func isBinary(t processor.Type) bool {
+	return processor.ScalarType(t) == "string" && processor.GetConstraints(t).Format == "binary"
+}
+
+func isNullable(t processor.Type) bool {
+	return processor.GetConstraints(t).Nullable
+}
+
+func optional(t string) string {
+	return "Optional[" + t + "]"
+}
+
+// pyFieldType returns the annotation of the dataclass field for prop.
+func pyFieldType(prop *processor.Property) string {
+	if !prop.Required() || isNullable(prop.Type) {
+		return optional(prop.Type.Name())
+	}
+
+	return prop.Type.Name()
+}
+
+func pyFieldDefault(prop *processor.Property) string {
+	if prop.Required() {
+		return ""
+	}
+
+	return " = None"
+}
+
+// pySortedFields returns the properties of the object with the required ones
+// first as dataclass fields without a default can't follow fields with one.
+func pySortedFields(t *processor.TypeObject) []*processor.Property {
+	properties := slices.Clone(t.Properties())
+	slices.SortStableFunc(properties, func(a, b *processor.Property) int {
+		switch {
+		case a.Required() == b.Required():
+			return 0
+		case a.Required():
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return properties
+}
+
+func decode(t processor.Type, expr string, depth int) string {
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return t.Name() + ".from_dict(" + expr + ")"
+	case *processor.TypeArray:
+		v := fmt.Sprintf("v%d", depth)
+
+		item := decode(t.Item, v, depth+1)
+		if item == v {
+			return expr
+		}
+
+		return fmt.Sprintf("[%s for %s in %s]", item, v, expr)
+	default:
+		return expr
+	}
+}
+
+func encode(t processor.Type, expr string, depth int) string {
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return expr + ".to_dict()"
+	case *processor.TypeArray:
+		v := fmt.Sprintf("v%d", depth)
+
+		item := encode(t.Item, v, depth+1)
+		if item == v {
+			return expr
+		}
+
+		return fmt.Sprintf("[%s for %s in %s]", item, v, expr)
+	default:
+		return expr
+	}
+}
+
+// pyEncode returns a Python expression converting expr into JSON compatible values.
+func pyEncode(t processor.Type, expr string) string {
+	return encode(t, expr, 0)
+}
+
+// pyDecodeProperty returns a Python expression reading prop from the dict `data`.
+func pyDecodeProperty(prop *processor.Property) string {
+	key := pyString(prop.WireName())
+
+	if prop.Required() && !isNullable(prop.Type) {
+		return decode(prop.Type, "data["+key+"]", 0)
+	}
+
+	value := "data.get(" + key + ")"
+
+	decoded := decode(prop.Type, "value", 0)
+	if decoded == "value" {
+		return value
+	}
+
+	return fmt.Sprintf("_optional(%s, lambda value: %s)", value, decoded)
+}
+
+// pyEncodeProperty returns a Python expression converting the field of prop in
+// `self` into JSON compatible values.
+func pyEncodeProperty(prop *processor.Property) string {
+	field := "self." + prop.Name()
+
+	encoded := pyEncode(prop.Type, "value")
+	if encoded == "value" {
+		return field
+	}
+
+	if prop.Required() && isNullable(prop.Type) {
+		return fmt.Sprintf("_optional(%s, lambda value: %s)", field, encoded)
+	}
+
+	return pyEncode(prop.Type, field)
+}
+
+// pyFormField returns the statement that adds prop, read from expr, to the
+// multipart `fields` list. Binary values are sent as files, objects as JSON parts
+// and everything else as text fields.
+func pyFormField(prop *processor.Property, expr string) string {
+	key := pyString(prop.WireName())
+
+	part := func(t processor.Type, value string) string {
+		switch {
+		case isBinary(t):
+			return fmt.Sprintf("_file_field(%s, %s)", key, value)
+		case t.Kind() == processor.KindIdentifierObject || t.Kind() == processor.KindIdentifierMap:
+			return fmt.Sprintf("_json_field(%s, %s)", key, pyEncode(t, value))
+		default:
+			return fmt.Sprintf("_text_field(%s, %s)", key, value)
+		}
+	}
+
+	if t, ok := prop.Type.(*processor.TypeArray); ok {
+		return fmt.Sprintf("fields.extend(%s for v in %s)", part(t.Item, "v"), expr)
+	}
+
+	return fmt.Sprintf("fields.append(%s)", part(prop.Type, expr))
+}
+
+func responseType(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return "None"
+	case r.MediaType == "application/json" && r.Type != nil:
+		return r.Type.Name()
+	case r.MediaType == "application/json":
+		return "Any"
+	default:
+		return "bytes"
+	}
+}
+
+// pyReturnType returns the type of the body of the FetchResponse returned by m.
+func pyReturnType(m *processor.Method) string {
+	types := make([]string, 0, 4) //nolint:mnd
+	for _, r := range m.SuccessResponses() {
+		if t := responseType(r); !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+
+	switch len(types) {
+	case 0:
+		return "None"
+	case 1:
+		return types[0]
+	default:
+		return "Union[" + strings.Join(types, ", ") + "]"
+	}
+}
+
+// pyDecodeResponse returns a Python expression reading the body of r from `response`.
+func pyDecodeResponse(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return "None"
+	case r.MediaType == "application/json" && r.Type != nil:
+		return decode(r.Type, "response.json()", 0)
+	case r.MediaType == "application/json":
+		return "response.json()"
+	default:
+		return "response.content"
+	}
+}
+
+func pyParameterType(param *processor.Parameter) string {
+	if param.Required() {
+		return param.Type.Name()
+	}
+
+	return optional(param.Type.Name())
+}
+
+func pyBodyType(m *processor.Method) string {
+	t := "bytes"
+
+	switch {
+	case m.RequestJSON() != nil:
+		t = m.RequestJSON().Name()
+	case m.RequestFormData() != nil:
+		t = m.RequestFormData().Name()
+	}
+
+	if !m.BodyRequired {
+		return optional(t)
+	}
+
+	return t
+}
@@ -0,0 +1,102 @@
+package python
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+// clientContext is the data passed to the client template, which renders both
+// the synchronous and the asynchronous client.
+type clientContext struct {
+	*processor.InterMediateRepresentation
+
+	Async bool
+}
+
+func pyClientContext(ir *processor.InterMediateRepresentation, async bool) *clientContext {
+	return &clientContext{InterMediateRepresentation: ir, Async: async}
+}
+
+// keywordParameters returns the parameters passed as keyword-only arguments.
+func keywordParameters(m *processor.Method) []*processor.Parameter {
+	return slices.Concat(m.QueryParameters(), m.HeaderParameters())
+}
+
+// pySignature returns the arguments of the functions implementing m, one per
+// line indented with indent spaces. Path parameters and the body are positional,
+// query and header parameters are keyword-only.
+func pySignature(m *processor.Method, indent int, withHeaders bool) string {
+	prefix := strings.Repeat(" ", indent)
+	lines := make([]string, 0, len(m.Parameters)+3) //nolint:mnd
+
+	for _, param := range m.PathParameters() {
+		lines = append(lines, prefix+param.Name()+": "+param.Type.Name()+",")
+	}
+
+	if m.RequestHasBody() && !m.IsRedirect() {
+		line := prefix + "body: " + pyBodyType(m)
+		if !m.BodyRequired {
+			line += " = None"
+		}
+
+		lines = append(lines, line+",")
+	}
+
+	keywords := keywordParameters(m)
+	if len(keywords) > 0 || withHeaders {
+		lines = append(lines, prefix+"*,")
+	}
+
+	// required keyword-only arguments are listed first for readability
+	slices.SortStableFunc(keywords, func(a, b *processor.Parameter) int {
+		switch {
+		case a.Required() == b.Required():
+			return 0
+		case a.Required():
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	for _, param := range keywords {
+		line := prefix + param.Name() + ": " + pyParameterType(param)
+		if !param.Required() {
+			line += " = None"
+		}
+
+		lines = append(lines, line+",")
+	}
+
+	if withHeaders {
+		lines = append(lines, prefix+"headers: Optional[Mapping[str, str]] = None,")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// pyCallArguments returns the arguments forwarding the signature of m to the
+// request builder.
+func pyCallArguments(m *processor.Method, withHeaders bool) string {
+	args := make([]string, 0, len(m.Parameters)+2) //nolint:mnd
+
+	for _, param := range m.PathParameters() {
+		args = append(args, param.Name())
+	}
+
+	if m.RequestHasBody() && !m.IsRedirect() {
+		args = append(args, "body")
+	}
+
+	for _, param := range keywordParameters(m) {
+		args = append(args, param.Name()+"="+param.Name())
+	}
+
+	if withHeaders {
+		args = append(args, "headers=headers")
+	}
+
+	return strings.Join(args, ", ")
+}
@@ -0,0 +1,238 @@
+package python
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// Python generates a self-contained module with dataclasses for objects, Literal
+// unions for enums and sync and async clients built on a pluggable transport.
+type Python struct{}
+
+func (p *Python) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (p *Python) GetFuncMap() map[string]any {
+	return map[string]any{
+		"pyString":         pyString,
+		"pyDocstring":      pyDocstring,
+		"pyDeprecated":     pyDeprecated,
+		"pyFieldType":      pyFieldType,
+		"pyFieldDefault":   pyFieldDefault,
+		"pySortedFields":   pySortedFields,
+		"pyDecodeProperty": pyDecodeProperty,
+		"pyEncodeProperty": pyEncodeProperty,
+		"pyEncode":         pyEncode,
+		"pyFormField":      pyFormField,
+		"pyReturnType":     pyReturnType,
+		"pyDecodeResponse": pyDecodeResponse,
+		"pyParameterType":  pyParameterType,
+		"pyBodyType":       pyBodyType,
+		"pyClientContext":  pyClientContext,
+		"pySignature":      pySignature,
+		"pyCallArguments":  pyCallArguments,
+	}
+}
+
+//nolint:gochecknoglobals
+var keywords = []string{
+	"False", "None", "True", "and", "as", "assert", "async", "await", "break",
+	"class", "continue", "def", "del", "elif", "else", "except", "finally", "for",
+	"from", "global", "if", "import", "in", "is", "lambda", "nonlocal", "not", "or",
+	"pass", "raise", "return", "try", "while", "with", "yield",
+}
+
+func identifier(name string) string {
+	name = format.ToSnakeCase(name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	if slices.Contains(keywords, name) {
+		return name + "_"
+	}
+
+	return name
+}
+
+func (p *Python) TypeObjectName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (p *Python) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (p *Python) TypeScalarName(scalar *processor.TypeScalar) string {
+	switch scalar.Schema().Schema().Type[0] {
+	case "string":
+		if scalar.Schema().Schema().Format == "binary" {
+			return "bytes"
+		}
+
+		return "str"
+	case "integer":
+		return "int"
+	case "number":
+		return "float"
+	case "boolean":
+		return "bool"
+	default:
+		return "Any"
+	}
+}
+
+func (p *Python) TypeArrayName(array *processor.TypeArray) string {
+	return "List[" + array.Item.Name() + "]"
+}
+
+func (p *Python) TypeEnumName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (p *Python) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			enumValues[i] = pyString(v)
+		case bool:
+			if v {
+				enumValues[i] = "True"
+			} else {
+				enumValues[i] = "False"
+			}
+		case nil:
+			enumValues[i] = "None"
+		default:
+			enumValues[i] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return enumValues
+}
+
+func (p *Python) TypeMapName(_ *processor.TypeMap) string {
+	return "Dict[str, Any]"
+}
+
+func (p *Python) MethodName(name string) string {
+	return identifier(name)
+}
+
+// MethodPath returns a Python expression that builds the path of the method.
+func (p *Python) MethodPath(segments []*processor.PathSegment) string {
+	parts := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		if !segment.IsParameter() {
+			parts = append(parts, pyString(segment.Literal))
+			continue
+		}
+
+		param := segment.Parameter
+		parts = append(parts, fmt.Sprintf(
+			"_serialize_path(%s, %s, %s, %s)",
+			pyString(param.WireName()),
+			pyEncode(param.Type, param.Name()),
+			pyString(string(param.Style())),
+			pyBool(param.Explode()),
+		))
+	}
+
+	if len(parts) == 0 {
+		return `""`
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+func (p *Python) ParameterName(name string) string {
+	return identifier(name)
+}
+
+func (p *Python) PropertyName(name string) string {
+	return identifier(name)
+}
+
+func (p *Python) BinaryType() string {
+	return "bytes"
+}
+
+// pyString returns s as a Python string literal.
+//
+//nolint:gochecknoglobals
+var pyString = processor.StringLiteral{Interpolation: "", BracedUnicode: false, SingleQuoted: false}.Quote
+
+func pyBool(b bool) string {
+	if b {
+		return "True"
+	}
+
+	return "False"
+}
+
+// pyDocstring returns a docstring indented with indent spaces built from the
+// non-empty parts, or an empty string if there is nothing to document.
+func pyDocstring(indent int, parts ...string) string {
+	paragraphs := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			part = strings.ReplaceAll(part, `\`, `\\`)
+			part = strings.ReplaceAll(part, `"""`, `\"\"\"`)
+			paragraphs = append(paragraphs, part)
+		}
+	}
+
+	if len(paragraphs) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+	lines := strings.Split(strings.Join(paragraphs, "\n\n"), "\n")
+
+	if len(lines) == 1 {
+		return prefix + `"""` + lines[0] + `"""`
+	}
+
+	var b strings.Builder
+
+	b.WriteString(prefix + `"""` + lines[0] + "\n")
+
+	for _, line := range lines[1:] {
+		if line == "" {
+			b.WriteString("\n")
+		} else {
+			b.WriteString(prefix + line + "\n")
+		}
+	}
+
+	b.WriteString(prefix + `"""`)
+
+	return b.String()
+}
+
+// pyDeprecated returns the docstring paragraph flagging an element as deprecated.
+func pyDeprecated(deprecated bool, message string) string {
+	switch {
+	case !deprecated:
+		return ""
+	case message == "":
+		return "Deprecated."
+	default:
+		return "Deprecated: " + message
+	}
+}
@@ -0,0 +1,299 @@
+package rust
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func isBinary(t processor.Type) bool {
+	return processor.ScalarType(t) == "string" && processor.GetConstraints(t).Format == "binary"
+}
+
+func isNullable(t processor.Type) bool {
+	return processor.GetConstraints(t).Nullable
+}
+
+// rustValueType returns the type of the value of prop. Values of a type in the same
+// cycle as the struct declaring them are boxed, otherwise the struct would have an
+// infinite size.
+func rustValueType(prop *processor.Property) string {
+	if prop.Cyclic() {
+		return "Box<" + prop.Type.Name() + ">"
+	}
+
+	return prop.Type.Name()
+}
+
+// rustFieldType returns the type of the field for prop. Fields that are both
+// optional and nullable distinguish a missing value (None) from null (Some(None)).
+func rustFieldType(prop *processor.Property) string {
+	switch {
+	case !prop.Required() && isNullable(prop.Type):
+		return "Option<Option<" + rustValueType(prop) + ">>"
+	case !prop.Required() || isNullable(prop.Type):
+		return "Option<" + rustValueType(prop) + ">"
+	default:
+		return rustValueType(prop)
+	}
+}
+
+// rustSerdeAttributes returns the serde attribute of the field for prop, or an
+// empty string if it doesn't need one.
+func rustSerdeAttributes(prop *processor.Property) string {
+	attrs := make([]string, 0, 4) //nolint:mnd
+
+	if unraw(prop.Name()) != prop.WireName() {
+		attrs = append(attrs, "rename = "+rustString(prop.WireName()))
+	}
+
+	if !prop.Required() {
+		attrs = append(attrs, "default", `skip_serializing_if = "Option::is_none"`)
+
+		if isNullable(prop.Type) {
+			attrs = append(attrs, `with = "double_option"`)
+		}
+	}
+
+	if len(attrs) == 0 {
+		return ""
+	}
+
+	return "#[serde(" + strings.Join(attrs, ", ") + ")]"
+}
+
+// EnumMember is a variant of a generated enum.
+type EnumMember struct {
+	Name string
+	// Value is the serde_json::Value the variant stands for
+	Value      string
+	Deprecated bool
+}
+
+//nolint:gochecknoglobals
+var enumReserved = []string{"Unknown", "Self"}
+
+// rustEnumMembers returns the variants of the enum with unique PascalCase names
+// derived from their values.
+func rustEnumMembers(t *processor.TypeEnum) []*EnumMember {
+	members := make([]*EnumMember, 0, len(t.EnumValues()))
+
+	for _, v := range t.EnumValues() {
+		name := format.Title(format.ToLowerCamelCase(fmt.Sprint(v.Raw())))
+
+		switch {
+		case name == "" || (name[0] >= '0' && name[0] <= '9'):
+			name = "Value" + name
+		case slices.Contains(enumReserved, name):
+			name += "Value"
+		}
+
+		unique := name
+		for i := 2; slices.ContainsFunc(members, func(m *EnumMember) bool {
+			return m.Name == unique
+		}); i++ {
+			unique = fmt.Sprintf("%s%d", name, i)
+		}
+
+		members = append(members, &EnumMember{
+			Name:       unique,
+			Value:      v.Value(),
+			Deprecated: v.Deprecated(),
+		})
+	}
+
+	return members
+}
+
+// rustFormTypes returns the multipart request bodies of the methods. Each of them
+// gets a method encoding it as a multipart body.
+func rustFormTypes(methods []*processor.Method) []*processor.TypeObject {
+	types := make([]*processor.TypeObject, 0)
+
+	for _, m := range methods {
+		t, ok := m.RequestFormData().(*processor.TypeObject)
+		if !ok || slices.ContainsFunc(types, func(o *processor.TypeObject) bool {
+			return o.Name() == t.Name()
+		}) {
+			continue
+		}
+
+		types = append(types, t)
+	}
+
+	return types
+}
+
+// rustFormField returns the statements that add prop, read from the place expr, to
+// the multipart `form`, indented with indent spaces. Binary values are sent as
+// files, objects as JSON parts and everything else as text fields.
+func rustFormField(prop *processor.Property, expr string, indent int) string {
+	key := rustString(prop.WireName())
+	prefix := strings.Repeat(" ", indent)
+
+	part := func(t processor.Type, value string) string {
+		switch {
+		case isBinary(t):
+			return fmt.Sprintf("form.add_file(%s, &%s.0);", key, value)
+		case t.Kind() == processor.KindIdentifierObject || t.Kind() == processor.KindIdentifierMap:
+			return fmt.Sprintf("form.add_json(%s, &%s)?;", key, value)
+		default:
+			return fmt.Sprintf("form.add_text(%s, &%s)?;", key, value)
+		}
+	}
+
+	field := func(value string, reference bool) string {
+		if t, ok := prop.Type.(*processor.TypeArray); ok {
+			if !reference {
+				value = "&" + value
+			}
+
+			return fmt.Sprintf(
+				"for item in %s {\n%s    %s\n%s}", value, prefix, part(t.Item, "item"), prefix,
+			)
+		}
+
+		return part(prop.Type, value)
+	}
+
+	switch rustFieldType(prop) {
+	case rustValueType(prop):
+		return field(expr, false)
+	case "Option<" + rustValueType(prop) + ">":
+		return fmt.Sprintf(
+			"if let Some(value) = &%s {\n%s    %s\n%s}",
+			expr, prefix, strings.ReplaceAll(field("value", true), "\n", "\n    "), prefix,
+		)
+	default:
+		return fmt.Sprintf(
+			"if let Some(Some(value)) = &%s {\n%s    %s\n%s}",
+			expr, prefix, strings.ReplaceAll(field("value", true), "\n", "\n    "), prefix,
+		)
+	}
+}
+
+func responseType(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return "()"
+	case r.MediaType == "application/json" && r.Type != nil:
+		return r.Type.Name()
+	case r.MediaType == "application/json":
+		return "serde_json::Value"
+	default:
+		return "Vec<u8>"
+	}
+}
+
+// rustReturnType returns the type of the body of the FetchResponse returned by m.
+// Methods returning different types per status code return the raw body.
+func rustReturnType(m *processor.Method) string {
+	types := make([]string, 0, 4) //nolint:mnd
+	for _, r := range m.SuccessResponses() {
+		if t := responseType(r); !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+
+	switch len(types) {
+	case 0:
+		return "()"
+	case 1:
+		return types[0]
+	default:
+		return "Vec<u8>"
+	}
+}
+
+// rustDecodeResponse returns a Rust expression reading the body of r from `data`.
+func rustDecodeResponse(m *processor.Method, r *processor.SuccessResponse) string {
+	if rustReturnType(m) == "Vec<u8>" {
+		return "data.to_vec()"
+	}
+
+	switch t := responseType(r); t {
+	case "()":
+		return "()"
+	default:
+		return "serde_json::from_slice::<" + t + ">(&data)?"
+	}
+}
+
+// rustErrorResponses returns the error responses of m documented with a status
+// code and a JSON body, sorted by code.
+func rustErrorResponses(m *processor.Method) []*processor.ErrorResponse {
+	return slices.DeleteFunc(m.ErrorResponses(), func(r *processor.ErrorResponse) bool {
+		return r.Type == nil
+	})
+}
+
+// rustErrorDefault returns the type of the body of the default response of m, or
+// an empty string if it isn't documented as JSON.
+func rustErrorDefault(m *processor.Method) string {
+	if t := m.DefaultResponseJSONType(); t != nil {
+		return t.Name()
+	}
+
+	return ""
+}
+
+// rustErrorType returns the type of the body of the errors returned by m. Methods
+// documenting typed error responses get an enum with a variant per response.
+func rustErrorType(m *processor.Method) string {
+	if len(rustErrorResponses(m)) == 0 && rustErrorDefault(m) == "" {
+		return "serde_json::Value"
+	}
+
+	return pascal(m.Name()) + "Error"
+}
+
+func rustBodyType(m *processor.Method) string {
+	switch {
+	case m.RequestJSON() != nil:
+		return m.RequestJSON().Name()
+	case m.RequestFormData() != nil:
+		return m.RequestFormData().Name()
+	default:
+		return "[u8]"
+	}
+}
+
+// rustParamsDefault returns true if the query parameters struct of m can derive
+// Default, which requires every parameter to be optional.
+func rustParamsDefault(m *processor.Method) bool {
+	return !slices.ContainsFunc(m.QueryParameters(), (*processor.Parameter).Required)
+}
+
+// rustArguments returns the parameter list of the method, one per line indented
+// with indent spaces and with a trailing comma.
+func rustArguments(m *processor.Method, indent int) string {
+	args := make([]string, 0, len(m.Parameters)+4) //nolint:mnd
+	args = append(args, "&self")
+
+	for _, param := range m.PathParameters() {
+		args = append(args, param.Name()+": &"+param.Type.Name())
+	}
+
+	if m.RequestHasBody() && !m.IsRedirect() {
+		if m.BodyRequired {
+			args = append(args, "body: &"+rustBodyType(m))
+		} else {
+			args = append(args, "body: Option<&"+rustBodyType(m)+">")
+		}
+	}
+
+	if m.HasQueryParameters() {
+		args = append(args, "params: Option<&"+pascal(m.Name())+"Params>")
+	}
+
+	if !m.IsRedirect() {
+		args = append(args, "headers: Option<&HashMap<String, String>>")
+	}
+
+	prefix := strings.Repeat(" ", indent)
+
+	return "\n" + prefix + "    " + strings.Join(args, ",\n"+prefix+"    ") + ",\n" + prefix
+}
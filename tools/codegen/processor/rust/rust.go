@@ -0,0 +1,240 @@
+package rust
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// Rust generates serde structs, enums with an unknown-value fallback and an async
+// client built on reqwest returning a typed error per operation.
+type Rust struct{}
+
+func (r *Rust) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (r *Rust) GetFuncMap() map[string]any {
+	return map[string]any{
+		"rustString":          rustString,
+		"rustDoc":             rustDoc,
+		"rustDeprecated":      rustDeprecated,
+		"rustFieldType":       rustFieldType,
+		"rustSerdeAttributes": rustSerdeAttributes,
+		"rustEnumMembers":     rustEnumMembers,
+		"rustFormTypes":       rustFormTypes,
+		"rustFormField":       rustFormField,
+		"rustReturnType":      rustReturnType,
+		"rustDecodeResponse":  rustDecodeResponse,
+		"rustErrorType":       rustErrorType,
+		"rustErrorResponses":  rustErrorResponses,
+		"rustErrorDefault":    rustErrorDefault,
+		"rustArguments":       rustArguments,
+		"rustParamsDefault":   rustParamsDefault,
+		"pascal":              pascal,
+		"unraw":               unraw,
+	}
+}
+
+//nolint:gochecknoglobals
+var keywords = []string{
+	"abstract", "as", "async", "await", "become", "box", "break", "const", "continue",
+	"crate", "do", "dyn", "else", "enum", "extern", "false", "final", "fn", "for", "gen",
+	"if", "impl", "in", "let", "loop", "macro", "match", "mod", "move", "mut",
+	"override", "priv", "pub", "ref", "return", "self", "static", "struct", "super",
+	"trait", "true", "try", "type", "typeof", "unsafe", "unsized", "use", "virtual",
+	"where", "while", "yield",
+}
+
+// keywords that can't be used as raw identifiers.
+//
+//nolint:gochecknoglobals
+var notRaw = []string{"crate", "self", "super"}
+
+// identifier converts name to snake_case and turns it into a raw identifier if it
+// is a keyword.
+func identifier(name string) string {
+	name = format.ToSnakeCase(name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	switch {
+	case slices.Contains(notRaw, name):
+		return name + "_"
+	case slices.Contains(keywords, name):
+		return "r#" + name
+	default:
+		return name
+	}
+}
+
+// unraw removes the prefix of a raw identifier.
+func unraw(name string) string {
+	return strings.TrimPrefix(name, "r#")
+}
+
+// pascal converts an identifier into a PascalCase type name.
+func pascal(name string) string {
+	return format.Title(format.ToLowerCamelCase(unraw(name)))
+}
+
+func (r *Rust) TypeObjectName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (r *Rust) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (r *Rust) TypeScalarName(scalar *processor.TypeScalar) string {
+	switch scalar.Schema().Schema().Type[0] {
+	case "string":
+		if scalar.Schema().Schema().Format == "binary" {
+			return "Base64Bytes"
+		}
+
+		return "String"
+	case "integer":
+		return "i64"
+	case "number":
+		return "f64"
+	case "boolean":
+		return "bool"
+	default:
+		return "serde_json::Value"
+	}
+}
+
+func (r *Rust) TypeArrayName(array *processor.TypeArray) string {
+	return "Vec<" + array.Item.Name() + ">"
+}
+
+func (r *Rust) TypeEnumName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+// TypeEnumValues returns the values as serde_json::Value expressions, which is how
+// the variants of the enums are matched while decoding.
+func (r *Rust) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			enumValues[i] = "serde_json::json!(" + rustString(v) + ")"
+		case nil:
+			enumValues[i] = "serde_json::Value::Null"
+		default:
+			enumValues[i] = fmt.Sprintf("serde_json::json!(%v)", v)
+		}
+	}
+
+	return enumValues
+}
+
+func (r *Rust) TypeMapName(_ *processor.TypeMap) string {
+	return "HashMap<String, serde_json::Value>"
+}
+
+func (r *Rust) MethodName(name string) string {
+	return identifier(name)
+}
+
+// MethodPath returns a Rust expression that builds the path of the method. The
+// expression uses `?` to return serialization errors.
+func (r *Rust) MethodPath(segments []*processor.PathSegment) string {
+	parts := make([]string, 0, len(segments))
+	literal := ""
+
+	for _, segment := range segments {
+		if !segment.IsParameter() {
+			parts = append(parts, rustString(segment.Literal))
+			literal += segment.Literal
+
+			continue
+		}
+
+		param := segment.Parameter
+		parts = append(parts, fmt.Sprintf(
+			"&serialize_path(%s, %s, %s, %t)?",
+			rustString(param.WireName()),
+			param.Name(),
+			rustString(string(param.Style())),
+			param.Explode(),
+		))
+	}
+
+	if len(parts) == 0 || !slices.ContainsFunc(segments, (*processor.PathSegment).IsParameter) {
+		return "String::from(" + rustString(literal) + ")"
+	}
+
+	return "[" + strings.Join(parts, ", ") + "].concat()"
+}
+
+func (r *Rust) ParameterName(name string) string {
+	return identifier(name)
+}
+
+func (r *Rust) PropertyName(name string) string {
+	return identifier(name)
+}
+
+func (r *Rust) BinaryType() string {
+	return "Vec<u8>"
+}
+
+// rustString returns s as a Rust string literal.
+//
+//nolint:gochecknoglobals
+var rustString = processor.StringLiteral{Interpolation: "", BracedUnicode: true, SingleQuoted: false}.Quote
+
+// rustDoc returns a documentation comment indented with indent spaces built from
+// the non-empty parts, or an empty string if there is nothing to document.
+func rustDoc(indent int, parts ...string) string {
+	paragraphs := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			paragraphs = append(paragraphs, part)
+		}
+	}
+
+	if len(paragraphs) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+	lines := strings.Split(strings.Join(paragraphs, "\n\n"), "\n")
+
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = prefix + "///"
+		} else {
+			lines[i] = prefix + "/// " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// rustDeprecated returns the deprecated attribute for an element, or an empty
+// string if it isn't deprecated.
+func rustDeprecated(deprecated bool, message string) string {
+	switch {
+	case !deprecated:
+		return ""
+	case message == "":
+		return "#[deprecated]"
+	default:
+		return "#[deprecated(note = " + rustString(message) + ")]"
+	}
+}
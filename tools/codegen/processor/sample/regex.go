@@ -0,0 +1,111 @@
+package sample
+
+import (
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// maxRepeat bounds the repetitions of unbounded quantifiers (*, + and {n,}).
+const maxRepeat = 5
+
+// asciiPrintable is the range of printable ASCII characters.
+var asciiPrintable = [2]rune{0x20, 0x7e} //nolint:gochecknoglobals
+
+// fromPattern returns a random string matching the pattern. It returns false if
+// the pattern uses a syntax unknown to RE2, e.g. lookarounds.
+func (g *Generator) fromPattern(pattern string) (string, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	var b strings.Builder
+	g.writeRegexp(&b, re.Simplify())
+
+	return b.String(), true
+}
+
+func (g *Generator) repeat(minimum, maximum int) int {
+	if maximum < 0 {
+		maximum = minimum + maxRepeat
+	}
+
+	if maximum <= minimum {
+		return minimum
+	}
+
+	return minimum + g.rand.IntN(maximum-minimum+1)
+}
+
+func (g *Generator) writeRegexp(b *strings.Builder, re *syntax.Regexp) { //nolint:cyclop
+	switch re.Op { //nolint:exhaustive
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		b.WriteRune(g.fromClass(re.Rune))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteRune(g.fromClass([]rune{'0', '9', 'A', 'Z', 'a', 'z'}))
+	case syntax.OpCapture:
+		g.writeRegexp(b, re.Sub[0])
+	case syntax.OpStar:
+		g.writeRepeat(b, re.Sub[0], 0, -1)
+	case syntax.OpPlus:
+		g.writeRepeat(b, re.Sub[0], 1, -1)
+	case syntax.OpQuest:
+		g.writeRepeat(b, re.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		g.writeRepeat(b, re.Sub[0], re.Min, re.Max)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.writeRegexp(b, sub)
+		}
+	case syntax.OpAlternate:
+		g.writeRegexp(b, re.Sub[g.rand.IntN(len(re.Sub))])
+	default:
+		// anchors, word boundaries and empty matches don't produce characters
+	}
+}
+
+func (g *Generator) writeRepeat(b *strings.Builder, re *syntax.Regexp, minimum, maximum int) {
+	for range g.repeat(minimum, maximum) {
+		g.writeRegexp(b, re)
+	}
+}
+
+// clip returns the parts of the ranges (pairs of first and last rune) that are
+// accepted by keep.
+func clip(ranges []rune, keep func(rune) bool) []rune {
+	clipped := make([]rune, 0, len(ranges))
+
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo := max(ranges[i], asciiPrintable[0])
+		hi := min(ranges[i+1], asciiPrintable[1])
+
+		for r := lo; r <= hi; r++ {
+			if keep(r) {
+				clipped = append(clipped, r)
+			}
+		}
+	}
+
+	return clipped
+}
+
+// fromClass returns a random rune of the class. Letters and digits are preferred,
+// then other printable ASCII characters, so negated classes produce readable values.
+func (g *Generator) fromClass(ranges []rune) rune {
+	isAlnum := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+	for _, keep := range []func(rune) bool{isAlnum, unicode.IsPrint} {
+		if candidates := clip(ranges, keep); len(candidates) > 0 {
+			return candidates[g.rand.IntN(len(candidates))]
+		}
+	}
+
+	if len(ranges) < 2 { //nolint:mnd
+		return 'x'
+	}
+
+	return ranges[0]
+}
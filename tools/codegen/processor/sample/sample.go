@@ -0,0 +1,368 @@
+// Package sample generates deterministic sample values for the types of the
+// intermediate representation, e.g. to answer requests in mock servers or to
+// fill fixtures in tests.
+package sample
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// maxDepth limits how deep recursive schemas are generated. Past it only
+	// required properties and items are generated and objects are cut short with null.
+	maxDepth = 8
+	// defaultRange is the width of the range numbers are picked from when the
+	// schema doesn't bound them
+	defaultRange = 1000
+	// maxPatternAttempts is how many strings matching a pattern are generated
+	// looking for one that also satisfies the length constraints
+	maxPatternAttempts = 10
+)
+
+var words = []string{ //nolint:gochecknoglobals
+	"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel",
+	"india", "juliett", "kilo", "lima", "mike", "november", "oscar", "papa",
+	"quebec", "romeo", "sierra", "tango", "uniform", "victor", "whiskey", "yankee",
+}
+
+//nolint:gochecknoglobals
+var epoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Generator produces sample values. Values only depend on the seed and on the
+// values generated before, so the same sequence of calls always returns the same
+// values.
+type Generator struct {
+	rand *rand.Rand
+	// Examples makes the generator return the example of a schema, when it has
+	// one, instead of a random value
+	Examples bool
+}
+
+func New(seed uint64) *Generator {
+	return &Generator{
+		rand:     rand.New(rand.NewPCG(seed, seed)), //nolint:gosec
+		Examples: false,
+	}
+}
+
+func decodeNode(node *yaml.Node) (any, bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return nil, false
+	}
+
+	return v, true
+}
+
+// Example returns the example, the first of the examples or the default of the schema.
+func Example(schema *base.SchemaProxy) (any, bool) {
+	if schema == nil || schema.Schema() == nil {
+		return nil, false
+	}
+
+	s := schema.Schema()
+
+	if v, ok := decodeNode(s.Example); ok {
+		return v, true
+	}
+
+	for _, example := range s.Examples {
+		if v, ok := decodeNode(example); ok {
+			return v, true
+		}
+	}
+
+	return decodeNode(s.Default)
+}
+
+// Value returns a sample value of the type that validates against its schema.
+// Optional properties are randomly left out and nullable values are never null.
+func (g *Generator) Value(t processor.Type) any {
+	return g.value(t, 0)
+}
+
+func (g *Generator) value(t processor.Type, depth int) any {
+	if t == nil {
+		return nil
+	}
+
+	if g.Examples {
+		if v, ok := Example(t.Schema()); ok {
+			return v
+		}
+	}
+
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return g.object(t, depth)
+	case *processor.TypeEnum:
+		if values := enumValues(t); len(values) > 0 {
+			return values[g.rand.IntN(len(values))]
+		}
+
+		return g.scalar(t)
+	case *processor.TypeAlias:
+		return g.value(t.Alias(), depth)
+	case *processor.TypeArray:
+		return g.array(t, depth)
+	case *processor.TypeMap:
+		return map[string]any{}
+	default:
+		return g.scalar(t)
+	}
+}
+
+// enumValues returns the values allowed by the schema of the enum. Enums referenced
+// from parameters carry no values in the IR so they are read from the schema.
+func enumValues(t *processor.TypeEnum) []any {
+	if t.Schema() == nil || t.Schema().Schema() == nil {
+		return nil
+	}
+
+	values := make([]any, 0, len(t.Schema().Schema().Enum))
+
+	for _, node := range t.Schema().Schema().Enum {
+		if v, ok := decodeNode(node); ok {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func (g *Generator) object(t *processor.TypeObject, depth int) any {
+	if depth > 2*maxDepth {
+		return nil
+	}
+
+	record := make(map[string]any, len(t.Properties()))
+
+	for _, prop := range t.Properties() {
+		if !prop.Required() && (depth > maxDepth || g.rand.IntN(2) == 0) {
+			continue
+		}
+
+		record[prop.WireName()] = g.value(prop.Type, depth+1)
+	}
+
+	return record
+}
+
+func (g *Generator) array(t *processor.TypeArray, depth int) any {
+	c := processor.GetConstraints(t)
+
+	minimum := 1
+	if c.MinItems != nil {
+		minimum = int(*c.MinItems)
+	}
+
+	maximum := minimum + 2 //nolint:mnd
+	if c.MaxItems != nil {
+		maximum = min(maximum, int(*c.MaxItems))
+	}
+
+	n := g.repeat(minimum, maximum)
+	if depth > maxDepth {
+		n = 0
+		if c.MinItems != nil {
+			n = int(*c.MinItems)
+		}
+	}
+
+	items := make([]any, n)
+	for i := range items {
+		items[i] = g.value(t.Item, depth+1)
+	}
+
+	return items
+}
+
+func (g *Generator) scalar(t processor.Type) any {
+	c := processor.GetConstraints(t)
+
+	switch processor.ScalarType(t) {
+	case "string":
+		return g.string(c)
+	case "integer":
+		return g.integer(c)
+	case "number":
+		return g.number(c)
+	case "boolean":
+		return g.rand.IntN(2) == 1
+	default:
+		return nil
+	}
+}
+
+func (g *Generator) word() string {
+	return words[g.rand.IntN(len(words))]
+}
+
+func (g *Generator) uuid() string {
+	b := make([]byte, 16) //nolint:mnd
+	for i := range b {
+		b[i] = byte(g.rand.UintN(256)) //nolint:mnd
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 //nolint:mnd
+	b[8] = (b[8] & 0x3f) | 0x80 //nolint:mnd
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (g *Generator) time() time.Time {
+	return epoch.Add(time.Duration(g.rand.Int64N(int64(365 * 24 * time.Hour)))).Truncate(time.Second)
+}
+
+func (g *Generator) format(format string) string { //nolint:cyclop
+	switch format {
+	case "email":
+		return g.word() + "." + g.word() + "@example.com"
+	case "uri", "url":
+		return "https://example.com/" + g.word()
+	case "hostname":
+		return g.word() + ".example.com"
+	case "uuid":
+		return g.uuid()
+	case "date-time":
+		return g.time().Format(time.RFC3339)
+	case "date":
+		return g.time().Format(time.DateOnly)
+	case "time":
+		return g.time().Format(time.TimeOnly)
+	case "ipv4":
+		return fmt.Sprintf(
+			"%d.%d.%d.%d", g.rand.IntN(256), g.rand.IntN(256), g.rand.IntN(256), g.rand.IntN(256), //nolint:mnd
+		)
+	case "ipv6":
+		return fmt.Sprintf("2001:db8::%x", g.rand.IntN(0xffff)) //nolint:mnd
+	case "byte":
+		return base64.StdEncoding.EncodeToString([]byte(g.word()))
+	case "binary":
+		return ""
+	case "password":
+		return g.fromClassString(12) //nolint:mnd
+	default:
+		n := g.repeat(1, 3) //nolint:mnd
+
+		parts := make([]string, n)
+		for i := range parts {
+			parts[i] = g.word()
+		}
+
+		return strings.Join(parts, " ")
+	}
+}
+
+func (g *Generator) fromClassString(n int) string {
+	runes := make([]rune, n)
+	for i := range runes {
+		runes[i] = g.fromClass([]rune{'0', '9', 'A', 'Z', 'a', 'z'})
+	}
+
+	return string(runes)
+}
+
+// fitLength pads or truncates the string to satisfy the length constraints.
+func (g *Generator) fitLength(s string, c *processor.Constraints) string {
+	runes := []rune(s)
+
+	if c.MinLength != nil && int64(len(runes)) < *c.MinLength {
+		runes = append(runes, []rune(g.fromClassString(int(*c.MinLength)-len(runes)))...)
+	}
+
+	if c.MaxLength != nil && int64(len(runes)) > *c.MaxLength {
+		runes = runes[:*c.MaxLength]
+	}
+
+	return string(runes)
+}
+
+func fitsLength(s string, c *processor.Constraints) bool {
+	n := int64(len([]rune(s)))
+	return (c.MinLength == nil || n >= *c.MinLength) && (c.MaxLength == nil || n <= *c.MaxLength)
+}
+
+func (g *Generator) string(c *processor.Constraints) string {
+	if c.Pattern != "" {
+		var s string
+
+		for range maxPatternAttempts {
+			var ok bool
+			if s, ok = g.fromPattern(c.Pattern); !ok {
+				break
+			}
+
+			if fitsLength(s, c) {
+				return s
+			}
+		}
+
+		if s != "" {
+			return g.fitLength(s, c)
+		}
+	}
+
+	return g.fitLength(g.format(c.Format), c)
+}
+
+// bounds returns the range numbers are picked from, honoring the constraints.
+// step is the distance used to move away from exclusive bounds.
+func bounds(c *processor.Constraints, step float64) (float64, float64) {
+	var lo, hi float64
+
+	switch {
+	case c.Minimum != nil && c.Maximum != nil:
+		lo, hi = *c.Minimum, *c.Maximum
+	case c.Minimum != nil:
+		lo, hi = *c.Minimum, *c.Minimum+defaultRange
+	case c.Maximum != nil:
+		lo, hi = *c.Maximum-defaultRange, *c.Maximum
+	default:
+		lo, hi = 0, defaultRange
+	}
+
+	if c.Minimum != nil && c.ExclusiveMinimum {
+		lo += step
+	}
+
+	if c.Maximum != nil && c.ExclusiveMaximum {
+		hi -= step
+	}
+
+	return lo, max(lo, hi)
+}
+
+func (g *Generator) integer(c *processor.Constraints) float64 {
+	lo, hi := bounds(c, 1)
+	lo, hi = math.Ceil(lo), math.Floor(hi)
+
+	if hi <= lo {
+		return lo
+	}
+
+	return lo + float64(g.rand.Int64N(int64(hi-lo)+1))
+}
+
+func (g *Generator) number(c *processor.Constraints) float64 {
+	const precision = 100
+
+	lo, hi := bounds(c, 1.0/precision)
+
+	v := math.Round((lo+g.rand.Float64()*(hi-lo))*precision) / precision
+
+	return min(max(v, lo), hi)
+}
@@ -0,0 +1,104 @@
+package sample_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/sample"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func getType(t *testing.T, name string) processor.Type {
+	t.Helper()
+
+	doc, err := spec.Load("testdata/sample.yaml")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	ir, err := processor.NewInterMediateRepresentation(doc, &typescript.Typescript{})
+	if err != nil {
+		t.Fatalf("failed to create intermediate representation: %v", err)
+	}
+
+	for _, typ := range ir.Types {
+		if typ.Name() == name {
+			return typ
+		}
+	}
+
+	t.Fatalf("type %s not found", name)
+
+	return nil
+}
+
+func TestValueDeterministic(t *testing.T) {
+	t.Parallel()
+
+	typ := getType(t, "Session")
+
+	assert.Equal(t, sample.New(42).Value(typ), sample.New(42).Value(typ))
+	assert.NotEqual(t, sample.New(42).Value(typ), sample.New(7).Value(typ))
+}
+
+func TestValueConstraints(t *testing.T) {
+	t.Parallel()
+
+	typ := getType(t, "Session")
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	tokenID := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	role := regexp.MustCompile(`^role:[a-z]{3,6}$`)
+
+	for seed := range uint64(100) {
+		session, ok := sample.New(seed).Value(typ).(map[string]any)
+		if !ok {
+			t.Fatalf("seed %d: expected an object", seed)
+		}
+
+		assert.GreaterOrEqual(t, len(session["accessToken"].(string)), 20) //nolint:forcetypeassert
+		assert.Regexp(t, tokenID, session["refreshTokenId"])
+		assert.GreaterOrEqual(t, session["expiresIn"], 60.0)
+		assert.Less(t, session["expiresIn"], 3600.0)
+
+		user := session["user"].(map[string]any) //nolint:forcetypeassert
+		assert.Regexp(t, uuid, user["id"])
+		assert.Regexp(t, `^[a-z]+\.[a-z]+@example\.com$`, user["email"])
+		assert.Contains(t, []any{"admin", "member"}, user["kind"])
+		assert.Greater(t, user["score"], 0.0)
+		assert.LessOrEqual(t, user["score"], 1.0)
+
+		if _, err := time.Parse(time.RFC3339, user["createdAt"].(string)); err != nil { //nolint:forcetypeassert
+			t.Errorf("seed %d: invalid date-time: %v", seed, err)
+		}
+
+		roles := user["roles"].([]any) //nolint:forcetypeassert
+		assert.GreaterOrEqual(t, len(roles), 2)
+		assert.LessOrEqual(t, len(roles), 3)
+
+		for _, r := range roles {
+			assert.Regexp(t, role, r)
+		}
+
+		if locale, ok := user["locale"]; ok {
+			assert.Len(t, locale, 2)
+		}
+	}
+}
+
+func TestValueExamples(t *testing.T) {
+	t.Parallel()
+
+	g := sample.New(0)
+	g.Examples = true
+
+	for range 20 {
+		user := g.Value(getType(t, "User")).(map[string]any) //nolint:forcetypeassert
+		if locale, ok := user["locale"]; ok {
+			assert.Equal(t, "en", locale)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/pb33f/libopenapi"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+var ErrSharedTypesUnsupported = errors.New("plugin doesn't support shared types")
+
+/*
+Several OpenAPI documents, one per service, can be generated together so the types
+they have in common are declared once in a shared module:
+
+- A type is shared when every service declaring a type with its name declares it
+  with the same structure and the types it uses are shared as well.
+- Types declared by several services with different structures are collisions. They
+  are kept in each service, either with their name or prefixed with the name of the
+  service when SharedOptions.Namespace is set.
+- Each service declares the rest of its types and imports and re-exports the shared
+  ones it declared.
+*/
+
+// ServiceDocument is the OpenAPI document of a service.
+type ServiceDocument struct {
+	// Name of the service, e.g. auth. Used to namespace colliding types
+	Name     string
+	Document *libopenapi.DocumentModel[v3.Document]
+}
+
+type SharedOptions struct {
+	// Options used to build the intermediate representation of every service
+	Options
+	// Namespace prefixes the types colliding with types of other services with the
+	// name of their service
+	Namespace bool
+}
+
+// Service is the intermediate representation of a service generated along others.
+type Service struct {
+	Name string
+	*InterMediateRepresentation
+}
+
+// Collision is a type name declared with different structures by several services.
+type Collision struct {
+	Name     string
+	Services []string
+}
+
+type SharedRepresentation struct {
+	plugin Plugin
+	// Types declared in the shared module
+	Types      []Type
+	Services   []*Service
+	Collisions []Collision
+}
+
+// servicePlugin renames the types of a service that collide with types of other
+// services.
+type servicePlugin struct {
+	Plugin
+	renames map[string]string
+}
+
+func (p *servicePlugin) rename(name string) string {
+	if renamed, ok := p.renames[name]; ok {
+		return renamed
+	}
+
+	return name
+}
+
+func (p *servicePlugin) TypeObjectName(name string) string {
+	return p.Plugin.TypeObjectName(p.rename(name))
+}
+
+func (p *servicePlugin) TypeEnumName(name string) string {
+	return p.Plugin.TypeEnumName(p.rename(name))
+}
+
+// declaration is a type declared by a service.
+type declaration struct {
+	service   int
+	t         Type
+	signature string
+}
+
+func NewSharedRepresentation(
+	documents []ServiceDocument, plugin Plugin, options SharedOptions,
+) (*SharedRepresentation, error) {
+	shared := &SharedRepresentation{
+		plugin:     plugin,
+		Types:      make([]Type, 0, 10), //nolint:mnd
+		Services:   make([]*Service, 0, len(documents)),
+		Collisions: make([]Collision, 0),
+	}
+
+	plugins := make([]*servicePlugin, 0, len(documents))
+	declarations := make(map[string][]declaration)
+	names := make([]string, 0, 10) //nolint:mnd
+
+	for i, doc := range documents {
+		p := &servicePlugin{Plugin: plugin, renames: make(map[string]string)}
+
+		ir, err := NewInterMediateRepresentationWithOptions(doc.Document, p, options.Options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create intermediate representation of %s: %w", doc.Name, err)
+		}
+
+		plugins = append(plugins, p)
+		shared.Services = append(shared.Services, &Service{
+			Name:                       doc.Name,
+			InterMediateRepresentation: ir,
+		})
+
+		for _, t := range ir.Types {
+			name, ok := typeName(t)
+			if !ok || slices.ContainsFunc(declarations[name], func(d declaration) bool {
+				return d.service == i
+			}) {
+				continue
+			}
+
+			if _, ok := declarations[name]; !ok {
+				names = append(names, name)
+			}
+
+			declarations[name] = append(declarations[name], declaration{
+				service:   i,
+				t:         t,
+				signature: signature(t),
+			})
+		}
+	}
+
+	isShared := sharedNames(names, declarations)
+
+	for _, name := range names {
+		decls := declarations[name]
+		if len(decls) < 2 { //nolint:mnd
+			continue
+		}
+
+		if isShared[name] {
+			shared.Types = append(shared.Types, decls[0].t)
+			continue
+		}
+
+		collision := Collision{Name: name, Services: make([]string, 0, len(decls))}
+
+		for _, d := range decls {
+			collision.Services = append(collision.Services, documents[d.service].Name)
+
+			if options.Namespace {
+				plugins[d.service].renames[name] = format.Title(documents[d.service].Name) + format.Title(name)
+			}
+		}
+
+		shared.Collisions = append(shared.Collisions, collision)
+	}
+
+	for _, service := range shared.Services {
+		service.removeShared(isShared)
+	}
+
+	return shared, nil
+}
+
+// sharedNames returns the names of the types that can be moved to the shared module.
+func sharedNames(names []string, declarations map[string][]declaration) map[string]bool {
+	shared := make(map[string]bool)
+
+	for _, name := range names {
+		decls := declarations[name]
+		if len(decls) < 2 { //nolint:mnd
+			continue
+		}
+
+		shared[name] = !slices.ContainsFunc(decls[1:], func(d declaration) bool {
+			return d.signature != decls[0].signature
+		})
+	}
+
+	// a shared type can't use types of a service
+	for changed := true; changed; {
+		changed = false
+
+		for _, name := range names {
+			if shared[name] && !usesOnly(declarations[name][0].t, shared, true) {
+				shared[name] = false
+				changed = true
+			}
+		}
+	}
+
+	return shared
+}
+
+// usesOnly returns true if the declared types used by t are all in names.
+func usesOnly(t Type, names map[string]bool, root bool) bool {
+	if name, ok := typeName(t); ok && !root {
+		return names[name]
+	}
+
+	for _, child := range children(t) {
+		if !usesOnly(child, names, false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// removeShared removes the shared types from the service. They are kept in
+// SharedTypes so the service can import them and export them as before.
+func (s *Service) removeShared(shared map[string]bool) {
+	types := make([]Type, 0, len(s.Types))
+	s.SharedTypes = make([]Type, 0)
+
+	for _, t := range s.Types {
+		if name, ok := typeName(t); ok && shared[name] {
+			s.SharedTypes = append(s.SharedTypes, t)
+			continue
+		}
+
+		types = append(types, t)
+	}
+
+	s.Types = types
+}
+
+// SupportsSharedTypes returns true if the plugin has a shared.tmpl template to render
+// the shared module.
+func SupportsSharedTypes(plugin Plugin) bool {
+	_, err := fs.Stat(plugin.GetTemplates(), "templates/shared.tmpl")
+	return err == nil
+}
+
+// Render writes the shared module using the shared.tmpl template of the plugin.
+func (s *SharedRepresentation) Render(out io.Writer) error {
+	tmpl, err := parseTemplates(s.plugin)
+	if err != nil {
+		return err
+	}
+
+	if tmpl.Lookup("shared.tmpl") == nil {
+		return ErrSharedTypesUnsupported
+	}
+
+	if err := tmpl.ExecuteTemplate(out, "shared.tmpl", s); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package processor_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/python"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func getServiceDocuments(t *testing.T) []processor.ServiceDocument {
+	t.Helper()
+
+	documents := make([]processor.ServiceDocument, 0, 2) //nolint:mnd
+
+	for _, name := range []string{"auth", "storage"} {
+		doc, err := getModel("testdata/shared/" + name + ".yaml")
+		if err != nil {
+			t.Fatalf("failed to get model: %v", err)
+		}
+
+		documents = append(documents, processor.ServiceDocument{Name: name, Document: doc})
+	}
+
+	return documents
+}
+
+func typeNames(types []processor.Type) []string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.Name()
+	}
+
+	return names
+}
+
+func TestSharedRepresentationRender(t *testing.T) {
+	t.Parallel()
+
+	shared, err := processor.NewSharedRepresentation(
+		getServiceDocuments(t),
+		&typescript.Typescript{},                 //nolint:exhaustruct
+		processor.SharedOptions{Namespace: true}, //nolint:exhaustruct
+	)
+	if err != nil {
+		t.Fatalf("failed to create shared representation: %v", err)
+	}
+
+	assert.Equal(t, []processor.Collision{
+		{Name: "User", Services: []string{"auth", "storage"}},
+		{Name: "Page", Services: []string{"auth", "storage"}},
+	}, shared.Collisions)
+
+	buf := bytes.NewBuffer(nil)
+	if err := shared.Render(buf); err != nil {
+		t.Fatalf("failed to render shared types: %v", err)
+	}
+
+	outputs := map[string]string{"types.ts": buf.String()}
+
+	for _, service := range shared.Services {
+		service.SharedModule = "../types"
+
+		buf := bytes.NewBuffer(nil)
+		if err := service.Render(buf); err != nil {
+			t.Fatalf("failed to render %s: %v", service.Name, err)
+		}
+
+		outputs[service.Name+".ts"] = buf.String()
+	}
+
+	for golden, output := range outputs {
+		b, err := os.ReadFile("testdata/shared/" + golden)
+		if err != nil {
+			t.Fatalf("failed to read expected output file: %v", err)
+		}
+
+		assert.Equal(t, string(b), output,
+			"rendered output does not match expected output for %s", golden)
+	}
+}
+
+func TestSharedRepresentationCollisions(t *testing.T) {
+	t.Parallel()
+
+	shared, err := processor.NewSharedRepresentation(
+		getServiceDocuments(t),
+		&typescript.Typescript{},                  //nolint:exhaustruct
+		processor.SharedOptions{Namespace: false}, //nolint:exhaustruct
+	)
+	if err != nil {
+		t.Fatalf("failed to create shared representation: %v", err)
+	}
+
+	assert.Equal(t, []string{"ErrorResponseCode", "ErrorResponse"}, typeNames(shared.Types))
+	assert.Equal(t, []string{"User", "Page", "Session"}, typeNames(shared.Services[0].Types))
+	assert.Equal(t, []string{"ErrorResponseCode", "ErrorResponse"}, typeNames(shared.Services[0].SharedTypes))
+	assert.Equal(t, []string{"User", "Page", "File"}, typeNames(shared.Services[1].Types))
+	assert.Equal(t, []string{"ErrorResponseCode", "ErrorResponse"}, typeNames(shared.Services[1].SharedTypes))
+}
+
+func TestSharedRepresentationUnsupported(t *testing.T) {
+	t.Parallel()
+
+	shared, err := processor.NewSharedRepresentation(
+		getServiceDocuments(t),
+		&python.Python{},
+		processor.SharedOptions{Namespace: false}, //nolint:exhaustruct
+	)
+	if err != nil {
+		t.Fatalf("failed to create shared representation: %v", err)
+	}
+
+	if err := shared.Render(bytes.NewBuffer(nil)); !errors.Is(err, processor.ErrSharedTypesUnsupported) {
+		t.Errorf("expected ErrSharedTypesUnsupported, got %v", err)
+	}
+
+	assert.False(t, processor.SupportsSharedTypes(&python.Python{}))
+	assert.True(t, processor.SupportsSharedTypes(&typescript.Typescript{})) //nolint:exhaustruct
+}
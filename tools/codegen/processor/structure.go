@@ -0,0 +1,149 @@
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// typeName returns the name given to the type in the OpenAPI document for the
+// types that are declared (objects, enums and aliases).
+func typeName(t Type) (string, bool) {
+	switch t := t.(type) {
+	case *TypeObject:
+		return t.name, true
+	case *TypeEnum:
+		return t.name, true
+	case *TypeAlias:
+		return t.name, true
+	default:
+		return "", false
+	}
+}
+
+// children returns the types the type is composed of.
+func children(t Type) []Type {
+	switch t := t.(type) {
+	case *TypeObject:
+		types := make([]Type, 0, len(t.properties))
+		for _, prop := range t.properties {
+			types = append(types, prop.Type)
+		}
+
+		return types
+	case *TypeArray:
+		return []Type{t.Item}
+	case *TypeAlias:
+		return []Type{t.alias}
+	default:
+		return nil
+	}
+}
+
+// methodTypes returns the types used by the parameters, bodies, responses, default
+// response and response headers of the method.
+func methodTypes(m *Method) []Type {
+	types := make([]Type, 0, len(m.Parameters)+len(m.Bodies)+len(m.Responses))
+
+	for _, param := range m.Parameters {
+		types = append(types, param.Type)
+	}
+
+	for _, media := range slices.Sorted(maps.Keys(m.Bodies)) {
+		types = append(types, m.Bodies[media])
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(m.Responses)) {
+		for _, media := range slices.Sorted(maps.Keys(m.Responses[code])) {
+			types = append(types, m.Responses[code][media])
+		}
+	}
+
+	for _, media := range slices.Sorted(maps.Keys(m.DefaultResponse)) {
+		types = append(types, m.DefaultResponse[media])
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(m.ResponseHeaders)) {
+		for _, header := range m.ResponseHeaders[code] {
+			types = append(types, header.Type)
+		}
+	}
+
+	return slices.DeleteFunc(types, func(t Type) bool { return t == nil })
+}
+
+// reference describes a type used by another one: declared types by their name
+// and anything else by its structure.
+func reference(t Type) string {
+	if name, ok := typeName(t); ok {
+		return "#" + name
+	}
+
+	return signature(t)
+}
+
+// signature describes the structure of the type, leaving out documentation such
+// as descriptions and examples, so two types generate the same code if and only
+// if they have the same name and signature.
+func signature(t Type) string {
+	var b strings.Builder
+
+	switch t := t.(type) {
+	case *TypeObject:
+		b.WriteString("object")
+
+		if t.input {
+			b.WriteString(" input")
+		}
+
+		b.WriteString("{")
+
+		for _, prop := range t.properties {
+			b.WriteString(prop.name)
+
+			if prop.Required() {
+				b.WriteString("!")
+			}
+
+			if prop.ReadOnly() {
+				b.WriteString(" readOnly")
+			}
+
+			if prop.WriteOnly() {
+				b.WriteString(" writeOnly")
+			}
+
+			b.WriteString(":" + reference(prop.Type) + ";")
+		}
+
+		b.WriteString("}")
+	case *TypeEnum:
+		fmt.Fprintf(&b, "enum%v", t.values)
+	case *TypeAlias:
+		b.WriteString("alias(" + reference(t.alias) + ")")
+	case *TypeArray:
+		b.WriteString("array(" + reference(t.Item) + ")" + constraintsSignature(t))
+	case *TypeMap:
+		b.WriteString("map")
+
+		// plugins can use extensions to pick the type of the values
+		for ext := t.schema.Schema().Extensions.First(); ext != nil; ext = ext.Next() {
+			b.WriteString(" " + ext.Key() + "=" + ext.Value().Value)
+		}
+	case *TypeScalar:
+		b.WriteString(ScalarType(t) + constraintsSignature(t))
+	}
+
+	return b.String()
+}
+
+func constraintsSignature(t Type) string {
+	b, err := json.Marshal(GetConstraints(t))
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
@@ -0,0 +1,206 @@
+package swift
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func isBinary(t processor.Type) bool {
+	return processor.ScalarType(t) == "string" && processor.GetConstraints(t).Format == "binary"
+}
+
+// swiftFieldType returns the type of the stored property for prop.
+func swiftFieldType(prop *processor.Property) string {
+	if !prop.Required() || processor.GetConstraints(prop.Type).Nullable {
+		return prop.Type.Name() + "?"
+	}
+
+	return prop.Type.Name()
+}
+
+// swiftIsClass returns true if the object holds a type of its own cycle. Structs
+// can't contain themselves, directly or not, so those objects are final classes.
+func swiftIsClass(t *processor.TypeObject) bool {
+	return slices.ContainsFunc(t.Properties(), (*processor.Property).Cyclic)
+}
+
+// EnumMember is a case of a generated Swift enum.
+type EnumMember struct {
+	Name string
+	// Pattern is the JSONValue matching the case
+	Pattern    string
+	Deprecated bool
+}
+
+// swiftEnumMembers returns the cases of the enum with unique identifiers derived
+// from their values. The unknown case is reserved for the fallback.
+func swiftEnumMembers(t *processor.TypeEnum) []*EnumMember {
+	members := make([]*EnumMember, 0, len(t.EnumValues()))
+
+	for _, v := range t.EnumValues() {
+		name := unquote(identifier(fmt.Sprint(v.Raw())))
+
+		switch {
+		case strings.HasPrefix(name, "_"):
+			name = "value" + strings.TrimPrefix(name, "_")
+		case name == "unknown" || name == "rawValue":
+			name += "Value"
+		}
+
+		unique := name
+		for i := 2; slices.ContainsFunc(members, func(m *EnumMember) bool {
+			return m.Name == unique
+		}); i++ {
+			unique = fmt.Sprintf("%s%d", name, i)
+		}
+
+		if slices.Contains(keywords, unique) {
+			unique = "`" + unique + "`"
+		}
+
+		members = append(members, &EnumMember{
+			Name:       unique,
+			Pattern:    v.Value(),
+			Deprecated: v.Deprecated(),
+		})
+	}
+
+	return members
+}
+
+// swiftFormField returns the statement that adds prop, read from expr, to the
+// multipart `form`. Binary values are sent as files, objects as JSON parts and
+// everything else as text fields.
+func swiftFormField(prop *processor.Property, expr string) string {
+	key := swiftString(prop.WireName())
+
+	part := func(t processor.Type, value string) string {
+		switch {
+		case isBinary(t):
+			return fmt.Sprintf("form.appendFile(name: %s, data: %s)", key, value)
+		case t.Kind() == processor.KindIdentifierObject || t.Kind() == processor.KindIdentifierMap:
+			return fmt.Sprintf("try form.appendJSON(name: %s, value: %s)", key, value)
+		default:
+			return fmt.Sprintf("try form.appendText(name: %s, value: %s)", key, value)
+		}
+	}
+
+	if t, ok := prop.Type.(*processor.TypeArray); ok {
+		return fmt.Sprintf("for item in %s { %s }", expr, part(t.Item, "item"))
+	}
+
+	return part(prop.Type, expr)
+}
+
+func responseType(r *processor.SuccessResponse) string {
+	switch {
+	case r.MediaType == "":
+		return "Void"
+	case r.MediaType == "application/json" && r.Type != nil:
+		return r.Type.Name()
+	case r.MediaType == "application/json":
+		return "JSONValue"
+	default:
+		return "Data"
+	}
+}
+
+// swiftReturnType returns the type of the body of the FetchResponse returned by
+// m. Methods returning different types per status code return the raw body.
+func swiftReturnType(m *processor.Method) string {
+	types := make([]string, 0, 4) //nolint:mnd
+	for _, r := range m.SuccessResponses() {
+		if t := responseType(r); !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+
+	switch len(types) {
+	case 0:
+		return "Void"
+	case 1:
+		return types[0]
+	default:
+		return "Data"
+	}
+}
+
+// swiftDecodeResponse returns a Swift expression reading the body of r from `data`.
+func swiftDecodeResponse(m *processor.Method, r *processor.SuccessResponse) string {
+	if swiftReturnType(m) == "Data" {
+		return "data"
+	}
+
+	switch t := responseType(r); t {
+	case "Void":
+		return "()"
+	case "Data":
+		return "data"
+	default:
+		return "try JSONDecoder().decode(" + t + ".self, from: data)"
+	}
+}
+
+// errorTypes decodes the bodies of error responses without a schema as JSONValue.
+//
+//nolint:gochecknoglobals
+var errorTypes = processor.ErrorTypes{Fallback: "JSONValue"}
+
+func swiftBodyType(m *processor.Method) string {
+	switch {
+	case m.RequestJSON() != nil:
+		return m.RequestJSON().Name()
+	case m.RequestFormData() != nil:
+		return m.RequestFormData().Name()
+	default:
+		return "Data"
+	}
+}
+
+// swiftArguments returns the parameter list of the method, one per line indented
+// with indent spaces.
+func swiftArguments(m *processor.Method, indent int) string {
+	args := make([]string, 0, len(m.Parameters)+3) //nolint:mnd
+
+	for _, param := range m.PathParameters() {
+		args = append(args, param.Name()+": "+param.Type.Name())
+	}
+
+	if m.RequestHasBody() && !m.IsRedirect() {
+		if m.BodyRequired {
+			args = append(args, "body: "+swiftBodyType(m))
+		} else {
+			args = append(args, "body: "+swiftBodyType(m)+"? = nil")
+		}
+	}
+
+	if m.HasQueryParameters() {
+		args = append(args, "params: "+format.Title(unquote(m.Name()))+"Params? = nil")
+	}
+
+	if !m.IsRedirect() {
+		args = append(args, "headers: [String: String] = [:]")
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+
+	return "\n" + prefix + "    " + strings.Join(args, ",\n"+prefix+"    ") + "\n" + prefix
+}
+
+// indented is a method rendered with its statements prefixed by Prefix.
+type indented struct {
+	Method *processor.Method
+	Prefix string
+}
+
+func swiftIndent(m *processor.Method, indent int) *indented {
+	return &indented{Method: m, Prefix: strings.Repeat(" ", indent)}
+}
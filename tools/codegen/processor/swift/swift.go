@@ -0,0 +1,244 @@
+package swift
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+// Swift generates Codable structs and enums and an async throws client built on
+// URLSession.
+type Swift struct{}
+
+func (s *Swift) GetTemplates() fs.FS {
+	return templatesFS
+}
+
+func (s *Swift) GetFuncMap() map[string]any {
+	return map[string]any{
+		"swiftString":          swiftString,
+		"swiftDoc":             swiftDoc,
+		"swiftDeprecated":      swiftDeprecated,
+		"swiftDeprecationNote": swiftDeprecationNote,
+		"swiftFieldType":       swiftFieldType,
+		"swiftIsClass":         swiftIsClass,
+		"swiftEnumMembers":     swiftEnumMembers,
+		"swiftFormField":       swiftFormField,
+		"swiftReturnType":      swiftReturnType,
+		"swiftDecodeResponse":  swiftDecodeResponse,
+		"swiftErrorResponses":  errorTypes.Responses,
+		"swiftErrorType":       errorTypes.Type,
+		"swiftErrorDefault":    errorTypes.Default,
+		"swiftArguments":       swiftArguments,
+		"swiftBodyType":        swiftBodyType,
+		"swiftIndent":          swiftIndent,
+		"unquote":              unquote,
+	}
+}
+
+//nolint:gochecknoglobals
+var keywords = []string{
+	"Any", "Protocol", "Self", "Type", "as", "associatedtype", "break", "case", "catch",
+	"class", "continue", "default", "defer", "deinit", "do", "else", "enum", "extension",
+	"fallthrough", "false", "fileprivate", "for", "func", "guard", "if", "import", "in",
+	"init", "inout", "internal", "is", "let", "nil", "operator", "precedencegroup",
+	"private", "protocol", "public", "repeat", "rethrows", "return", "self", "static",
+	"struct", "subscript", "super", "switch", "throw", "throws", "true", "try",
+	"typealias", "var", "where", "while",
+}
+
+// identifier converts name to lowerCamelCase and escapes it with backticks if it
+// is a reserved word.
+func identifier(name string) string {
+	name = format.ToLowerCamelCase(name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	if slices.Contains(keywords, name) {
+		return "`" + name + "`"
+	}
+
+	return name
+}
+
+// unquote removes the backticks escaping a reserved word, which are not valid in
+// every position (e.g. after a dot).
+func unquote(name string) string {
+	return strings.Trim(name, "`")
+}
+
+func (s *Swift) TypeObjectName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+func (s *Swift) TypeInputName(name string) string {
+	return name + "Input"
+}
+
+func (s *Swift) TypeScalarName(scalar *processor.TypeScalar) string {
+	switch scalar.Schema().Schema().Type[0] {
+	case "string":
+		if scalar.Schema().Schema().Format == "binary" {
+			return "Data"
+		}
+
+		return "String"
+	case "integer":
+		return "Int"
+	case "number":
+		return "Double"
+	case "boolean":
+		return "Bool"
+	default:
+		return "JSONValue"
+	}
+}
+
+func (s *Swift) TypeArrayName(array *processor.TypeArray) string {
+	return "[" + array.Item.Name() + "]"
+}
+
+func (s *Swift) TypeEnumName(name string) string {
+	return format.ToCamelCase(name)
+}
+
+// TypeEnumValues returns the values as JSONValue patterns, which is how enums are
+// matched while decoding.
+func (s *Swift) TypeEnumValues(values []any) []string {
+	enumValues := make([]string, len(values))
+
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			enumValues[i] = ".string(" + swiftString(v) + ")"
+		case bool:
+			enumValues[i] = fmt.Sprintf(".bool(%t)", v)
+		case int, int64, uint64, float64:
+			enumValues[i] = fmt.Sprintf(".number(%v)", v)
+		case nil:
+			enumValues[i] = ".null"
+		default:
+			enumValues[i] = ".string(" + swiftString(fmt.Sprint(v)) + ")"
+		}
+	}
+
+	return enumValues
+}
+
+func (s *Swift) TypeMapName(_ *processor.TypeMap) string {
+	return "[String: JSONValue]"
+}
+
+func (s *Swift) MethodName(name string) string {
+	return identifier(name)
+}
+
+// MethodPath returns a Swift expression that builds the path of the method. The
+// expression throws if a parameter can't be serialized.
+func (s *Swift) MethodPath(segments []*processor.PathSegment) string {
+	parts := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		if !segment.IsParameter() {
+			parts = append(parts, swiftString(segment.Literal))
+			continue
+		}
+
+		param := segment.Parameter
+		parts = append(parts, fmt.Sprintf(
+			"serializePath(%s, %s, style: %s, explode: %t)",
+			swiftString(param.WireName()),
+			param.Name(),
+			swiftString(string(param.Style())),
+			param.Explode(),
+		))
+	}
+
+	if len(parts) == 0 {
+		return `""`
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+func (s *Swift) ParameterName(name string) string {
+	return identifier(name)
+}
+
+func (s *Swift) PropertyName(name string) string {
+	return identifier(name)
+}
+
+func (s *Swift) BinaryType() string {
+	return "Data"
+}
+
+// swiftString returns s as a Swift string literal.
+//
+//nolint:gochecknoglobals
+var swiftString = processor.StringLiteral{Interpolation: "", BracedUnicode: true, SingleQuoted: false}.Quote
+
+// swiftDoc returns a documentation comment indented with indent spaces built from
+// the non-empty parts, or an empty string if there is nothing to document.
+func swiftDoc(indent int, parts ...string) string {
+	paragraphs := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			paragraphs = append(paragraphs, part)
+		}
+	}
+
+	if len(paragraphs) == 0 {
+		return ""
+	}
+
+	prefix := strings.Repeat(" ", indent)
+	lines := strings.Split(strings.Join(paragraphs, "\n\n"), "\n")
+
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = prefix + "///"
+		} else {
+			lines[i] = prefix + "/// " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// swiftDeprecationNote returns the paragraph documenting a deprecated element, or
+// an empty string if it isn't deprecated. It is used where the availability
+// attribute would trigger warnings inside the generated code itself.
+func swiftDeprecationNote(deprecated bool, message string) string {
+	switch {
+	case !deprecated:
+		return ""
+	case message == "":
+		return "- Warning: Deprecated."
+	default:
+		return "- Warning: Deprecated. " + message
+	}
+}
+
+// swiftDeprecated returns the availability attribute for a deprecated element, or
+// an empty string if it isn't deprecated.
+func swiftDeprecated(deprecated bool, message string) string {
+	switch {
+	case !deprecated:
+		return ""
+	case message == "":
+		return "@available(*, deprecated)"
+	default:
+		return "@available(*, deprecated, message: " + swiftString(message) + ")"
+	}
+}
@@ -0,0 +1,177 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+
+/**
+ * A two-characters locale
+ */
+export type Locale = string;
+
+
+/**
+ * Options for the new user.
+ @property displayName? (`string`) - 
+    *    Example - `"John Smith"`
+    *    Pattern - ^[\p{L}\p{N}\p{S} ,.'-]+$
+    *    MaxLength - 32
+ @property locale? (`string`) - A two-characters locale
+    *    Example - `"en"`
+    *    MinLength - 2
+    *    MaxLength - 2
+ @property redirectTo? (`string`) - 
+    *    Format - uri
+ @property allowedRoles? (`string[]`) - */
+export interface SignUpOptions {
+  /**
+   * 
+    *    Example - `"John Smith"`
+    *    Pattern - ^[\p{L}\p{N}\p{S} ,.'-]+$
+    *    MaxLength - 32
+   */
+  displayName?: string,
+  /**
+   * A two-characters locale
+    *    Example - `"en"`
+    *    MinLength - 2
+    *    MaxLength - 2
+   */
+  locale?: string,
+  /**
+   * 
+    *    Format - uri
+   */
+  redirectTo?: string,
+  /**
+   * 
+   */
+  allowedRoles?: string[],
+};
+
+
+/**
+ * Request to sign up with email and password.
+ @property email (`string`) - A valid email
+    *    Format - email
+ @property password (`string`) - A password of minimum 3 characters
+    *    MinLength - 3
+    *    MaxLength - 50
+ @property age? (`number`) - Age of the user
+ @property score? (`number`) - A strictly positive score
+ @property options? (`SignUpOptions`) - Options for the new user.*/
+export interface SignUpEmailPasswordRequest {
+  /**
+   * A valid email
+    *    Format - email
+   */
+  email: string,
+  /**
+   * A password of minimum 3 characters
+    *    MinLength - 3
+    *    MaxLength - 50
+   */
+  password: string,
+  /**
+   * Age of the user
+   */
+  age?: number,
+  /**
+   * A strictly positive score
+   */
+  score?: number,
+  /**
+   * Options for the new user.
+   */
+  options?: SignUpOptions,
+};
+
+
+/**
+ * Response of the sign up.
+ @property userId (`string`) - 
+    *    Format - uuid
+ @property referrer? (`string`) - */
+export interface SignUpResponse {
+  /**
+   * 
+    *    Format - uuid
+   */
+  userId: string,
+  /**
+   * 
+   */
+  referrer?: string,
+};
+
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     Summary: Sign up with email and password
+     Register a new user using an email and a password.
+
+     This method may return different T based on the response code:
+     - 200: SignUpResponse
+     - 204: void
+     */
+  signUpEmailPassword(
+    body: SignUpEmailPasswordRequest,
+    options?: RequestInit,
+  ): Promise<FetchResponse<SignUpResponse | void>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  signUpEmailPassword = async (
+    body: SignUpEmailPasswordRequest,
+    options?: RequestInit,
+  ): Promise<FetchResponse<SignUpResponse | void>> => {
+    const url = baseURL + `/signup/email-password`;
+    const res = await fetch(url, {
+      ...options,
+      method: "POST",
+      headers: {
+        "Content-Type": "application/json",
+        ...options?.headers,
+      },
+      body: JSON.stringify(body),
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: SignUpResponse | void = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<SignUpResponse | void>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      signUpEmailPassword,
+  };
+};
@@ -120,23 +120,41 @@ export const createAPIClient = (
     provider: SignInProvider,
     params?: SignInProviderParams,
   ): string => {
-  const encodedParameters =
-    params &&
-    Object.entries(params)
-      .map(([key, value]) => {
-        const stringValue = Array.isArray(value)
-          ? value.join(',')
-          : typeof value === 'object'
-          ? JSON.stringify(value)
-          : (value as string)
-        return `${key}=${encodeURIComponent(stringValue)}`
-      })
-      .join('&')
+    const query: string[] = [];
+    if (params?.["allowedRoles"] !== undefined) {
+      const value = params["allowedRoles"];
+      query.push(`allowedRoles=${value.map((v) => encodeURIComponent(String(v))).join(",")}`);
+    }
+    if (params?.["defaultRole"] !== undefined) {
+      const value = params["defaultRole"];
+      query.push(`defaultRole=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["displayName"] !== undefined) {
+      const value = params["displayName"];
+      query.push(`displayName=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["locale"] !== undefined) {
+      const value = params["locale"];
+      query.push(`locale=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["metadata"] !== undefined) {
+      const value = params["metadata"];
+      query.push(`metadata=${encodeURIComponent(JSON.stringify(value))}`);
+    }
+    if (params?.["redirectTo"] !== undefined) {
+      const value = params["redirectTo"];
+      query.push(`redirectTo=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["connect"] !== undefined) {
+      const value = params["connect"];
+      query.push(`connect=${encodeURIComponent(String(value))}`);
+    }
+    const encodedParameters = query.filter((part) => part !== "").join("&");
 
     const url =
      encodedParameters
-        ? baseURL + `/signin/provider/${provider}?${encodedParameters}`
-        : baseURL + `/signin/provider/${provider}`;
+        ? baseURL + `/signin/provider/${encodeURIComponent(String(provider))}?${encodedParameters}`
+        : baseURL + `/signin/provider/${encodeURIComponent(String(provider))}`;
     return url;
   };
 
@@ -0,0 +1,177 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+
+/**
+ * Role of the user.
+ * Deprecated values: "anonymous"
+ */
+export type Role = "user" | "admin" | "anonymous";
+
+
+/**
+ * Legacy identifier.
+ * @deprecated
+ */
+export type LegacyId = string;
+
+
+/**
+ * Session returned by legacy sign in methods.
+ * @deprecated Sessions are now returned by the Session schema.
+ @property accessToken (`string`) - Access token.
+ @property legacyId? (`string`) - Legacy identifier.*/
+export interface LegacySession {
+  /**
+   * Access token.
+   */
+  accessToken: string,
+  /**
+   * Legacy identifier.
+   */
+  legacyId?: string,
+};
+
+
+/**
+ * Attachment of the security key. Detected automatically.
+ * @deprecated
+ */
+export type SignInWebauthnRequestAttachment = "platform" | "cross-platform";
+
+
+/**
+ * Request to sign in with a security key.
+ @property email? (`string`) - A valid email. No longer used.
+    *    Format - email
+ @property credential (`string`) - Credential returned by the security key.
+ @property attachment? (`SignInWebauthnRequestAttachment`) - Attachment of the security key. Detected automatically.*/
+export interface SignInWebauthnRequest {
+  /**
+   * A valid email. No longer used.
+    *    Format - email
+   * @deprecated Sunset: 2025-12-01
+   */
+  email?: string,
+  /**
+   * Credential returned by the security key.
+   */
+  credential: string,
+  /**
+   * Attachment of the security key. Detected automatically.
+   * @deprecated
+   */
+  attachment?: SignInWebauthnRequestAttachment,
+};
+
+/**
+ * Parameters for the signInWebauthn method.
+    @property connection? (string) - Connection to use. Will be ignored.
+  
+    @property role? (Role) - Role to sign in with.
+  
+    *    Role of the user.*/
+export interface SignInWebauthnParams {
+  /**
+   * Connection to use. Will be ignored.
+  
+   * @deprecated
+   */
+  connection?: string;
+  /**
+   * Role to sign in with.
+  
+    *    Role of the user.
+   */
+  role?: Role;
+}
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     Summary: Sign in with a security key
+     Sign in using a security key registered previously.
+
+     This method may return different T based on the response code:
+     - 200: LegacySession
+
+     @deprecated Use signInPasskey instead. Sunset: 2026-01-01
+     */
+  signInWebauthn(
+    body: SignInWebauthnRequest,
+    params?: SignInWebauthnParams,
+    options?: RequestInit,
+  ): Promise<FetchResponse<LegacySession>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  signInWebauthn = async (
+    body: SignInWebauthnRequest,
+    params?: SignInWebauthnParams,
+    options?: RequestInit,
+  ): Promise<FetchResponse<LegacySession>> => {
+    const query: string[] = [];
+    if (params?.["connection"] !== undefined) {
+      const value = params["connection"];
+      query.push(`connection=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["role"] !== undefined) {
+      const value = params["role"];
+      query.push(`role=${encodeURIComponent(String(value))}`);
+    }
+    const encodedParameters = query.filter((part) => part !== "").join("&");
+
+    const url =
+     encodedParameters
+        ? baseURL + `/signin/webauthn?${encodedParameters}`
+        : baseURL + `/signin/webauthn`;
+    const res = await fetch(url, {
+      ...options,
+      method: "POST",
+      headers: {
+        "Content-Type": "application/json",
+        ...options?.headers,
+      },
+      body: JSON.stringify(body),
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: LegacySession = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<LegacySession>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      signInWebauthn,
+  };
+};
@@ -0,0 +1,153 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+
+/**
+ * 
+ @property id (`string`) - */
+export interface User {
+  /**
+   * 
+   */
+  id: string,
+};
+
+
+/**
+ * 
+ @property users? (`User2[]`) - */
+export interface Profiles {
+  /**
+   * 
+   */
+  users?: User2[],
+};
+
+
+/**
+ * 
+ @property age? (`number`) - 
+ @property name (`string`) - */
+export interface User2 {
+  /**
+   * 
+   */
+  age?: number,
+  /**
+   * 
+   */
+  name: string,
+};
+
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: User
+     */
+  getUser(
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User>>;
+
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: User2
+     */
+  getProfile(
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User2>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  getUser = async (
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User>> => {
+    const url = baseURL + `/users/${encodeURIComponent(String(id))}`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: User = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<User>;
+
+  };
+
+    const  getProfile = async (
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User2>> => {
+    const url = baseURL + `/profiles/${encodeURIComponent(String(id))}`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: User2 = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<User2>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      getUser,
+      getProfile,
+  };
+};
@@ -606,6 +606,116 @@ export interface VerifyTicketParams {
    */
   redirectTo: RedirectToQuery;
 }
+/**
+ * Headers returned by the getFileMetadataHeaders method. Values are parsed from the raw response
+ * headers and are undefined if the server didn't send them or they are not exposed.
+    @property Cache-Control? (string) - Directives for caching mechanisms
+    @property Content-Length? (number) - Size of the file in bytes
+    @property Etag? (string) - Entity tag for cache validation
+    @property Last-Modified? (Date) - Date and time the file was last modified
+ */
+export interface GetFileMetadataHeadersResponseHeaders {
+  /**
+   * Directives for caching mechanisms
+   */
+  "Cache-Control"?: string;
+  /**
+   * Size of the file in bytes
+   */
+  "Content-Length"?: number;
+  /**
+   * Entity tag for cache validation
+   */
+  Etag?: string;
+  /**
+   * Date and time the file was last modified
+   */
+  "Last-Modified"?: Date;
+}
+
+const parseGetFileMetadataHeadersResponseHeaders = (headers: Headers): GetFileMetadataHeadersResponseHeaders => {
+  const typedHeaders: GetFileMetadataHeadersResponseHeaders = {};
+  {
+    const value = headers.get("Cache-Control");
+    if (value !== null) {
+      typedHeaders["Cache-Control"] = value;
+    }
+  }
+  {
+    const value = headers.get("Content-Length");
+    if (value !== null) {
+      typedHeaders["Content-Length"] = Number(value);
+    }
+  }
+  {
+    const value = headers.get("Etag");
+    if (value !== null) {
+      typedHeaders["Etag"] = value;
+    }
+  }
+  {
+    const value = headers.get("Last-Modified");
+    if (value !== null) {
+      typedHeaders["Last-Modified"] = new Date(value);
+    }
+  }
+  return typedHeaders;
+};
+/**
+ * Headers returned by the getFile method. Values are parsed from the raw response
+ * headers and are undefined if the server didn't send them or they are not exposed.
+    @property Cache-Control? (string) - Directives for caching mechanisms
+    @property Content-Length? (number) - Size of the file in bytes
+    @property Etag? (string) - Entity tag for cache validation
+    @property Last-Modified? (Date) - Date and time the file was last modified
+ */
+export interface GetFileResponseHeaders {
+  /**
+   * Directives for caching mechanisms
+   */
+  "Cache-Control"?: string;
+  /**
+   * Size of the file in bytes
+   */
+  "Content-Length"?: number;
+  /**
+   * Entity tag for cache validation
+   */
+  Etag?: string;
+  /**
+   * Date and time the file was last modified
+   */
+  "Last-Modified"?: Date;
+}
+
+const parseGetFileResponseHeaders = (headers: Headers): GetFileResponseHeaders => {
+  const typedHeaders: GetFileResponseHeaders = {};
+  {
+    const value = headers.get("Cache-Control");
+    if (value !== null) {
+      typedHeaders["Cache-Control"] = value;
+    }
+  }
+  {
+    const value = headers.get("Content-Length");
+    if (value !== null) {
+      typedHeaders["Content-Length"] = Number(value);
+    }
+  }
+  {
+    const value = headers.get("Etag");
+    if (value !== null) {
+      typedHeaders["Etag"] = value;
+    }
+  }
+  {
+    const value = headers.get("Last-Modified");
+    if (value !== null) {
+      typedHeaders["Last-Modified"] = new Date(value);
+    }
+  }
+  return typedHeaders;
+};
 
 
 export interface Client {
@@ -650,7 +760,7 @@ export interface Client {
     id: FileId,
     params?: GetFileMetadataHeadersParams,
     options?: RequestInit,
-  ): Promise<FetchResponse<void>>;
+  ): Promise<FetchResponse<void> & { typedHeaders: GetFileMetadataHeadersResponseHeaders }>;
 
     /**
      Summary: Download file
@@ -666,7 +776,7 @@ export interface Client {
     id: FileId,
     params?: GetFileParams,
     options?: RequestInit,
-  ): Promise<FetchResponse<Blob>>;
+  ): Promise<FetchResponse<Blob> & { typedHeaders: GetFileResponseHeaders }>;
 
     /**
      Summary: Replace file
@@ -809,24 +919,34 @@ export const createAPIClient = (
     id: FileId,
     params?: GetFileMetadataHeadersParams,
     options?: RequestInit,
-  ): Promise<FetchResponse<void>> => {
-  const encodedParameters =
-    params &&
-    Object.entries(params)
-      .map(([key, value]) => {
-        const stringValue = Array.isArray(value)
-          ? value.join(',')
-          : typeof value === 'object'
-          ? JSON.stringify(value)
-          : (value as string)
-        return `${key}=${encodeURIComponent(stringValue)}`
-      })
-      .join('&')
+  ): Promise<FetchResponse<void> & { typedHeaders: GetFileMetadataHeadersResponseHeaders }> => {
+    const query: string[] = [];
+    if (params?.["q"] !== undefined) {
+      const value = params["q"];
+      query.push(`q=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["h"] !== undefined) {
+      const value = params["h"];
+      query.push(`h=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["w"] !== undefined) {
+      const value = params["w"];
+      query.push(`w=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["b"] !== undefined) {
+      const value = params["b"];
+      query.push(`b=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["f"] !== undefined) {
+      const value = params["f"];
+      query.push(`f=${encodeURIComponent(String(value))}`);
+    }
+    const encodedParameters = query.filter((part) => part !== "").join("&");
 
     const url =
      encodedParameters
-        ? baseURL + `/files/${id}?${encodedParameters}`
-        : baseURL + `/files/${id}`;
+        ? baseURL + `/files/${encodeURIComponent(String(id))}?${encodedParameters}`
+        : baseURL + `/files/${encodeURIComponent(String(id))}`;
     const res = await fetch(url, {
       ...options,
       method: "HEAD",
@@ -848,7 +968,8 @@ export const createAPIClient = (
       body: payload,
       status: res.status,
       headers: res.headers,
-    } as FetchResponse<void>;
+      typedHeaders: parseGetFileMetadataHeadersResponseHeaders(res.headers),
+    } as FetchResponse<void> & { typedHeaders: GetFileMetadataHeadersResponseHeaders };
 
   };
 
@@ -856,24 +977,34 @@ export const createAPIClient = (
     id: FileId,
     params?: GetFileParams,
     options?: RequestInit,
-  ): Promise<FetchResponse<Blob>> => {
-  const encodedParameters =
-    params &&
-    Object.entries(params)
-      .map(([key, value]) => {
-        const stringValue = Array.isArray(value)
-          ? value.join(',')
-          : typeof value === 'object'
-          ? JSON.stringify(value)
-          : (value as string)
-        return `${key}=${encodeURIComponent(stringValue)}`
-      })
-      .join('&')
+  ): Promise<FetchResponse<Blob> & { typedHeaders: GetFileResponseHeaders }> => {
+    const query: string[] = [];
+    if (params?.["q"] !== undefined) {
+      const value = params["q"];
+      query.push(`q=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["h"] !== undefined) {
+      const value = params["h"];
+      query.push(`h=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["w"] !== undefined) {
+      const value = params["w"];
+      query.push(`w=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["b"] !== undefined) {
+      const value = params["b"];
+      query.push(`b=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["f"] !== undefined) {
+      const value = params["f"];
+      query.push(`f=${encodeURIComponent(String(value))}`);
+    }
+    const encodedParameters = query.filter((part) => part !== "").join("&");
 
     const url =
      encodedParameters
-        ? baseURL + `/files/${id}?${encodedParameters}`
-        : baseURL + `/files/${id}`;
+        ? baseURL + `/files/${encodeURIComponent(String(id))}?${encodedParameters}`
+        : baseURL + `/files/${encodeURIComponent(String(id))}`;
     const res = await fetch(url, {
       ...options,
       method: "GET",
@@ -895,7 +1026,8 @@ export const createAPIClient = (
       body: payload,
       status: res.status,
       headers: res.headers,
-    } as FetchResponse<Blob>;
+      typedHeaders: parseGetFileResponseHeaders(res.headers),
+    } as FetchResponse<Blob> & { typedHeaders: GetFileResponseHeaders };
 
   };
 
@@ -904,7 +1036,7 @@ export const createAPIClient = (
     body?: ReplaceFileBody,
     options?: RequestInit,
   ): Promise<FetchResponse<FileMetadata>> => {
-    const url = baseURL + `/files/${id}`;
+    const url = baseURL + `/files/${encodeURIComponent(String(id))}`;
     const formData = new FormData();
     if (body["metadata"] !== undefined) {
       formData.append(
@@ -945,7 +1077,7 @@ export const createAPIClient = (
     id: FileId,
     options?: RequestInit,
   ): Promise<FetchResponse<void>> => {
-    const url = baseURL + `/files/${id}`;
+    const url = baseURL + `/files/${encodeURIComponent(String(id))}`;
     const res = await fetch(url, {
       ...options,
       method: "DELETE",
@@ -974,18 +1106,16 @@ export const createAPIClient = (
     const  verifyTicketURL = (
     params?: VerifyTicketParams,
   ): string => {
-  const encodedParameters =
-    params &&
-    Object.entries(params)
-      .map(([key, value]) => {
-        const stringValue = Array.isArray(value)
-          ? value.join(',')
-          : typeof value === 'object'
-          ? JSON.stringify(value)
-          : (value as string)
-        return `${key}=${encodeURIComponent(stringValue)}`
-      })
-      .join('&')
+    const query: string[] = [];
+    if (params?.["ticket"] !== undefined) {
+      const value = params["ticket"];
+      query.push(`ticket=${encodeURIComponent(String(value))}`);
+    }
+    if (params?.["redirectTo"] !== undefined) {
+      const value = params["redirectTo"];
+      query.push(`redirectTo=${encodeURIComponent(String(value))}`);
+    }
+    const encodedParameters = query.filter((part) => part !== "").join("&");
 
     const url =
      encodedParameters
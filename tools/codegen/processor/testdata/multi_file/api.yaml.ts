@@ -0,0 +1,271 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+
+/**
+ * Error returned by every service
+ @property error (`string`) - Error code
+ @property message? (`string`) - */
+export interface ErrorResponse {
+  /**
+   * Error code
+   */
+  error: string,
+  /**
+   * 
+   */
+  message?: string,
+};
+
+
+/**
+ * 
+ @property page (`Page`) - 
+ @property users (`User[]`) - */
+export interface UserPage {
+  /**
+   * 
+   */
+  page: Page,
+  /**
+   * 
+   */
+  users: User[],
+};
+
+
+/**
+ * 
+ @property pageInfo (`PageInfo`) - 
+ @property total? (`number`) - */
+export interface Page {
+  /**
+   * 
+   */
+  pageInfo: PageInfo,
+  /**
+   * 
+   */
+  total?: number,
+};
+
+
+/**
+ * 
+ @property offset (`number`) - 
+ @property limit (`number`) - */
+export interface PageInfo {
+  /**
+   * 
+   */
+  offset: number,
+  /**
+   * 
+   */
+  limit: number,
+};
+
+
+/**
+ * 
+ @property city? (`string`) - */
+export interface UserAddress {
+  /**
+   * 
+   */
+  city?: string,
+};
+
+
+/**
+ * 
+ @property id (`string`) - 
+    *    Format - uuid
+ @property role (`Role`) - 
+ @property address? (`UserAddress`) - */
+export interface User {
+  /**
+   * 
+    *    Format - uuid
+   */
+  id: string,
+  /**
+   * 
+   */
+  role: Role,
+  /**
+   * 
+   */
+  address?: UserAddress,
+};
+
+
+/**
+ * 
+ */
+export type Role = "admin" | "user";
+
+
+/**
+ * 
+ @property theme? (`string`) - */
+export interface UserSettings {
+  /**
+   * 
+   */
+  theme?: string,
+};
+
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: UserPage
+     */
+  listUsers(
+    options?: RequestInit,
+  ): Promise<FetchResponse<UserPage>>;
+
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: User
+     */
+  getUser(
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User>>;
+
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: UserSettings
+     */
+  getUserSettings(
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<UserSettings>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  listUsers = async (
+    options?: RequestInit,
+  ): Promise<FetchResponse<UserPage>> => {
+    const url = baseURL + `/users`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: UserPage = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<UserPage>;
+
+  };
+
+    const  getUser = async (
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User>> => {
+    const url = baseURL + `/users/${encodeURIComponent(String(id))}`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: User = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<User>;
+
+  };
+
+    const  getUserSettings = async (
+    id: string,
+    options?: RequestInit,
+  ): Promise<FetchResponse<UserSettings>> => {
+    const url = baseURL + `/users/${encodeURIComponent(String(id))}/settings`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: UserSettings = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<UserSettings>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      listUsers,
+      getUser,
+      getUserSettings,
+  };
+};
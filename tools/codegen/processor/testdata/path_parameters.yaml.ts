@@ -0,0 +1,161 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+/**
+ * Parameters for the getFile method.
+    @property version? (number) - Version of the file, a number
+  */
+export interface GetFileParams {
+  /**
+   * Version of the file, a number
+  
+   */
+  version?: number;
+}
+/**
+ * Parameters for the deleteFile method.
+    @property version? (string) - Version of the file, overridden by getFile
+  */
+export interface DeleteFileParams {
+  /**
+   * Version of the file, overridden by getFile
+  
+   */
+  version?: string;
+}
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     Summary: Get a file
+     
+
+     This method may return different T based on the response code:
+     - 200: void
+     */
+  getFile(
+    id: string,
+    params?: GetFileParams,
+    options?: RequestInit,
+  ): Promise<FetchResponse<Blob>>;
+
+    /**
+     Summary: Delete a file
+     
+
+     This method may return different T based on the response code:
+     - 204: void
+     */
+  deleteFile(
+    id: string,
+    params?: DeleteFileParams,
+    options?: RequestInit,
+  ): Promise<FetchResponse<void>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  getFile = async (
+    id: string,
+    params?: GetFileParams,
+    options?: RequestInit,
+  ): Promise<FetchResponse<Blob>> => {
+    const query: string[] = [];
+    if (params?.["version"] !== undefined) {
+      const value = params["version"];
+      query.push(`version=${encodeURIComponent(String(value))}`);
+    }
+    const encodedParameters = query.filter((part) => part !== "").join("&");
+
+    const url =
+     encodedParameters
+        ? baseURL + `/files/${encodeURIComponent(String(id))}?${encodedParameters}`
+        : baseURL + `/files/${encodeURIComponent(String(id))}`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const payload: Blob = await res.blob();
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<Blob>;
+
+  };
+
+    const  deleteFile = async (
+    id: string,
+    params?: DeleteFileParams,
+    options?: RequestInit,
+  ): Promise<FetchResponse<void>> => {
+    const query: string[] = [];
+    if (params?.["version"] !== undefined) {
+      const value = params["version"];
+      query.push(`version=${encodeURIComponent(String(value))}`);
+    }
+    const encodedParameters = query.filter((part) => part !== "").join("&");
+
+    const url =
+     encodedParameters
+        ? baseURL + `/files/${encodeURIComponent(String(id))}?${encodedParameters}`
+        : baseURL + `/files/${encodeURIComponent(String(id))}`;
+    const res = await fetch(url, {
+      ...options,
+      method: "DELETE",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const payload: void = undefined;
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<void>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      getFile,
+      deleteFile,
+  };
+};
@@ -0,0 +1,82 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     Summary: Get a file
+     Get a file using every supported path parameter style.
+
+     This method may return different T based on the response code:
+     - 204: void
+     */
+  getBucketFile(
+    bucket: string,
+    name: string[],
+    ext: string,
+    coords: number[],
+    tags: string[],
+    point: Record<string, unknown>,
+    options?: RequestInit,
+  ): Promise<FetchResponse<void>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  getBucketFile = async (
+    bucket: string,
+    name: string[],
+    ext: string,
+    coords: number[],
+    tags: string[],
+    point: Record<string, unknown>,
+    options?: RequestInit,
+  ): Promise<FetchResponse<void>> => {
+    const url = baseURL + `/buckets/${encodeURIComponent(String(bucket))}/files/${name.map((v) => encodeURIComponent(String(v))).join(",")}${"." + encodeURIComponent(String(ext))}${"." + coords.map((v) => encodeURIComponent(String(v))).join(".")}${";tags=" + tags.map((v) => encodeURIComponent(String(v))).join(",")}${";" + Object.entries(point).map(([k, v]) => `${encodeURIComponent(k)}=${encodeURIComponent(String(v))}`).join(";")}`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const payload: void = undefined;
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<void>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      getBucketFile,
+  };
+};
@@ -0,0 +1,214 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+
+/**
+ * Postal address.
+ @property street (`string`) - Street name and number.
+ @property verified (`boolean`) - Whether the address has been verified.*/
+export interface Address {
+  /**
+   * Street name and number.
+   */
+  street: string,
+  /**
+   * Whether the address has been verified.
+   */
+  verified: boolean,
+};
+
+
+/**
+ * Postal address.
+ @property street (`string`) - Street name and number.*/
+export interface AddressInput {
+  /**
+   * Street name and number.
+   */
+  street: string,
+};
+
+
+/**
+ * User account.
+ @property id (`string`) - Unique identifier of the user.
+ @property email (`string`) - Email of the user.
+    *    Format - email
+ @property createdAt (`string`) - Timestamp when the user was created.
+    *    Format - date-time
+ @property address? (`Address`) - Postal address.*/
+export interface User {
+  /**
+   * Unique identifier of the user.
+   */
+  id: string,
+  /**
+   * Email of the user.
+    *    Format - email
+   */
+  email: string,
+  /**
+   * Timestamp when the user was created.
+    *    Format - date-time
+   */
+  createdAt: string,
+  /**
+   * Postal address.
+   */
+  address?: Address,
+};
+
+
+/**
+ * User account.
+ @property email (`string`) - Email of the user.
+    *    Format - email
+ @property password (`string`) - Password of the user.
+ @property address? (`AddressInput`) - Postal address.*/
+export interface UserInput {
+  /**
+   * Email of the user.
+    *    Format - email
+   */
+  email: string,
+  /**
+   * Password of the user.
+   */
+  password: string,
+  /**
+   * Postal address.
+   */
+  address?: AddressInput,
+};
+
+
+/**
+ * 
+ @property addresses (`AddressInput[]`) - */
+export interface ReplaceAddressesBody {
+  /**
+   * 
+   */
+  addresses: AddressInput[],
+};
+
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     Summary: Create a user
+     Create a new user. Server generated fields are ignored.
+
+     This method may return different T based on the response code:
+     - 200: User
+     */
+  createUser(
+    body: UserInput,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User>>;
+
+    /**
+     Summary: Replace the addresses of a user
+     Replace all the addresses of a user.
+
+     This method may return different T based on the response code:
+     - 200: Address[]
+     */
+  replaceAddresses(
+    id: string,
+    body: ReplaceAddressesBody,
+    options?: RequestInit,
+  ): Promise<FetchResponse<Address[]>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  createUser = async (
+    body: UserInput,
+    options?: RequestInit,
+  ): Promise<FetchResponse<User>> => {
+    const url = baseURL + `/users`;
+    const res = await fetch(url, {
+      ...options,
+      method: "POST",
+      headers: {
+        "Content-Type": "application/json",
+        ...options?.headers,
+      },
+      body: JSON.stringify(body),
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: User = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<User>;
+
+  };
+
+    const  replaceAddresses = async (
+    id: string,
+    body: ReplaceAddressesBody,
+    options?: RequestInit,
+  ): Promise<FetchResponse<Address[]>> => {
+    const url = baseURL + `/users/${encodeURIComponent(String(id))}/addresses`;
+    const res = await fetch(url, {
+      ...options,
+      method: "PUT",
+      headers: {
+        "Content-Type": "application/json",
+        ...options?.headers,
+      },
+      body: JSON.stringify(body),
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: Address[] = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<Address[]>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      createUser,
+      replaceAddresses,
+  };
+};
@@ -0,0 +1,164 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+import type { ErrorResponseCode, ErrorResponse } from "../types";
+
+export type { ErrorResponseCode, ErrorResponse };
+
+/**
+ * 
+ @property id (`string`) - 
+ @property email? (`string`) - 
+    *    Format - email*/
+export interface AuthUser {
+  /**
+   * 
+   */
+  id: string,
+  /**
+   * 
+    *    Format - email
+   */
+  email?: string,
+};
+
+
+/**
+ * 
+ @property items (`AuthUser[]`) - 
+ @property next? (`string`) - */
+export interface AuthPage {
+  /**
+   * 
+   */
+  items: AuthUser[],
+  /**
+   * 
+   */
+  next?: string,
+};
+
+
+/**
+ * 
+ @property accessToken (`string`) - 
+ @property user (`AuthUser`) - */
+export interface Session {
+  /**
+   * 
+   */
+  accessToken: string,
+  /**
+   * 
+   */
+  user: AuthUser,
+};
+
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: Session
+     */
+  getSession(
+    options?: RequestInit,
+  ): Promise<FetchResponse<Session>>;
+
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: AuthPage
+     */
+  listUsers(
+    options?: RequestInit,
+  ): Promise<FetchResponse<AuthPage>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  getSession = async (
+    options?: RequestInit,
+  ): Promise<FetchResponse<Session>> => {
+    const url = baseURL + `/session`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: Session = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<Session>;
+
+  };
+
+    const  listUsers = async (
+    options?: RequestInit,
+  ): Promise<FetchResponse<AuthPage>> => {
+    const url = baseURL + `/users`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: AuthPage = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<AuthPage>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      getSession,
+      listUsers,
+  };
+};
@@ -0,0 +1,167 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+import { FetchError, createEnhancedFetch } from "../fetch";
+import type { ChainFunction, FetchResponse } from "../fetch";
+import type { ErrorResponseCode, ErrorResponse } from "../types";
+
+export type { ErrorResponseCode, ErrorResponse };
+
+/**
+ * 
+ @property id (`string`) - 
+ @property bucket? (`string`) - */
+export interface StorageUser {
+  /**
+   * 
+   */
+  id: string,
+  /**
+   * 
+   */
+  bucket?: string,
+};
+
+
+/**
+ * 
+ @property items (`StorageUser[]`) - 
+ @property next? (`string`) - */
+export interface StoragePage {
+  /**
+   * 
+   */
+  items: StorageUser[],
+  /**
+   * 
+   */
+  next?: string,
+};
+
+
+/**
+ * 
+ @property id (`string`) - 
+ @property uploadedBy? (`StorageUser`) - 
+ @property error? (`ErrorResponse`) - Error returned by the API*/
+export interface File {
+  /**
+   * 
+   */
+  id: string,
+  /**
+   * 
+   */
+  uploadedBy?: StorageUser,
+  /**
+   * Error returned by the API
+   */
+  error?: ErrorResponse,
+};
+
+
+
+export interface Client {
+  baseURL: string;
+  pushChainFunction(chainFunction: ChainFunction): void;
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: File[]
+     */
+  listFiles(
+    options?: RequestInit,
+  ): Promise<FetchResponse<File[]>>;
+
+    /**
+     
+
+     This method may return different T based on the response code:
+     - 200: StoragePage
+     */
+  listUsers(
+    options?: RequestInit,
+  ): Promise<FetchResponse<StoragePage>>;
+};
+
+
+export const createAPIClient = (
+  baseURL: string,
+  chainFunctions: ChainFunction[] = [],
+): Client => {
+  let fetch = createEnhancedFetch(chainFunctions);
+
+  const pushChainFunction = (chainFunction: ChainFunction) => {
+    chainFunctions.push(chainFunction);
+    fetch = createEnhancedFetch(chainFunctions);
+  };
+    const  listFiles = async (
+    options?: RequestInit,
+  ): Promise<FetchResponse<File[]>> => {
+    const url = baseURL + `/files`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: File[] = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<File[]>;
+
+  };
+
+    const  listUsers = async (
+    options?: RequestInit,
+  ): Promise<FetchResponse<StoragePage>> => {
+    const url = baseURL + `/users`;
+    const res = await fetch(url, {
+      ...options,
+      method: "GET",
+      headers: {
+        ...options?.headers,
+      },
+    });
+
+    if (res.status >= 300) {
+      const responseBody = [412].includes(res.status) ? null : await res.text();
+      const payload: unknown = responseBody ? JSON.parse(responseBody) : {};
+      throw new FetchError(payload, res.status, res.headers);
+    }
+    
+    const responseBody = [204, 205, 304].includes(res.status) ? null : await res.text();
+    const payload: StoragePage = responseBody ? JSON.parse(responseBody) : {};
+    
+
+    return {
+      body: payload,
+      status: res.status,
+      headers: res.headers,
+    } as FetchResponse<StoragePage>;
+
+  };
+
+
+  return {
+    baseURL,
+    pushChainFunction,
+      listFiles,
+      listUsers,
+  };
+};
@@ -0,0 +1,25 @@
+/**
+ * This file is auto-generated. Do not edit manually.
+ */
+
+/**
+ * 
+ */
+export type ErrorResponseCode = "not-found" | "forbidden";
+
+
+/**
+ * Error returned by the service
+ @property message (`string`) - Human readable message
+ @property code (`ErrorResponseCode`) - */
+export interface ErrorResponse {
+  /**
+   * Human readable message
+   */
+  message: string,
+  /**
+   * 
+   */
+  code: ErrorResponseCode,
+};
+
@@ -24,13 +24,14 @@ type Plugin interface { //nolint:interfacebloat
 	GetTemplates() fs.FS
 	GetFuncMap() map[string]any
 	TypeObjectName(name string) string
+	TypeInputName(name string) string
 	TypeScalarName(scalar *TypeScalar) string
 	TypeArrayName(array *TypeArray) string
 	TypeEnumName(name string) string
 	TypeEnumValues(values []any) []string
 	TypeMapName(mapType *TypeMap) string
 	MethodName(name string) string
-	MethodPath(name string) string
+	MethodPath(segments []*PathSegment) string
 	ParameterName(name string) string
 	PropertyName(name string) string
 	BinaryType() string
@@ -46,7 +47,9 @@ type TypeObject struct {
 	name       string
 	schema     *base.SchemaProxy
 	properties []*Property
-	p          Plugin
+	// input is true if this object is the request (input) view of a schema
+	input bool
+	p     Plugin
 }
 
 func (t *TypeObject) Name() string {
@@ -61,8 +64,34 @@ func (t *TypeObject) Schema() *base.SchemaProxy {
 	return t.schema
 }
 
+// Properties returns the properties visible in this view of the object. Input views
+// already exclude readOnly properties, output views exclude writeOnly ones.
 func (t *TypeObject) Properties() []*Property {
-	return t.properties
+	if t.input {
+		return t.properties
+	}
+
+	properties := make([]*Property, 0, len(t.properties))
+	for _, prop := range t.properties {
+		if !prop.WriteOnly() {
+			properties = append(properties, prop)
+		}
+	}
+
+	return properties
+}
+
+func (t *TypeObject) Deprecated() bool {
+	return schemaDeprecated(t.schema)
+}
+
+func (t *TypeObject) DeprecationMessage() string {
+	return schemaDeprecationMessage(t.schema)
+}
+
+// IsInput returns true if the object is the request view of a schema.
+func (t *TypeObject) IsInput() bool {
+	return t.input
 }
 
 type Property struct {
@@ -72,13 +101,27 @@ type Property struct {
 	Parent Type
 	// The type of the property
 	Type Type
-	p    Plugin
+	// cyclic is true if the property holds a type in the same cycle as Parent
+	cyclic bool
+	p      Plugin
 }
 
 func (p *Property) Name() string {
 	return p.p.PropertyName(p.name)
 }
 
+// Cyclic returns true if the property holds a value of a type in the same cycle as
+// the object declaring it (see TypeGroup), e.g. a `parent` property of `Node`
+// referencing `Node`. Arrays of such types aren't cyclic, only their items are.
+func (p *Property) Cyclic() bool {
+	return p.cyclic
+}
+
+// WireName returns the name of the property as it appears in the payload.
+func (p *Property) WireName() string {
+	return p.name
+}
+
 func (p *Property) Required() bool {
 	return slices.Contains(
 		p.Parent.Schema().Schema().Required,
@@ -86,11 +129,39 @@ func (p *Property) Required() bool {
 	)
 }
 
+// Deprecated returns true if the property is marked as deprecated. Properties
+// referencing another schema are never deprecated themselves.
+func (p *Property) Deprecated() bool {
+	if p.Type.Schema().IsReference() {
+		return false
+	}
+
+	return schemaDeprecated(p.Type.Schema())
+}
+
+func (p *Property) DeprecationMessage() string {
+	return schemaDeprecationMessage(p.Type.Schema())
+}
+
+// ReadOnly returns true if the property is only sent by the server.
+func (p *Property) ReadOnly() bool {
+	ro := p.Type.Schema().Schema().ReadOnly
+	return ro != nil && *ro
+}
+
+// WriteOnly returns true if the property is only sent by the client.
+func (p *Property) WriteOnly() bool {
+	wo := p.Type.Schema().Schema().WriteOnly
+	return wo != nil && *wo
+}
+
 type TypeEnum struct {
 	name   string
 	schema *base.SchemaProxy
 	values []any
-	p      Plugin
+	// component is false for enums declared inline, e.g. by a property
+	component bool
+	p         Plugin
 }
 
 func (t *TypeEnum) Name() string {
@@ -101,6 +172,39 @@ func (t *TypeEnum) Values() []string {
 	return t.p.TypeEnumValues(t.values)
 }
 
+// EnumValues returns the values of the enum with their metadata.
+func (t *TypeEnum) EnumValues() []*EnumValue {
+	values := make([]*EnumValue, len(t.values))
+	for i, v := range t.values {
+		values[i] = &EnumValue{
+			value:  v,
+			parent: t,
+		}
+	}
+
+	return values
+}
+
+// DeprecatedValues returns the values of the enum that are deprecated formatted by the plugin.
+func (t *TypeEnum) DeprecatedValues() []string {
+	values := make([]string, 0, len(t.values))
+	for _, v := range t.EnumValues() {
+		if v.Deprecated() {
+			values = append(values, v.Value())
+		}
+	}
+
+	return values
+}
+
+func (t *TypeEnum) Deprecated() bool {
+	return schemaDeprecated(t.schema)
+}
+
+func (t *TypeEnum) DeprecationMessage() string {
+	return schemaDeprecationMessage(t.schema)
+}
+
 func (t *TypeEnum) Kind() KindIdentifier {
 	return KindIdentifierEnum
 }
@@ -124,6 +228,14 @@ func (t *TypeAlias) Alias() Type { //nolint:ireturn
 	return t.alias
 }
 
+func (t *TypeAlias) Deprecated() bool {
+	return schemaDeprecated(t.schema)
+}
+
+func (t *TypeAlias) DeprecationMessage() string {
+	return schemaDeprecationMessage(t.schema)
+}
+
 func (t *TypeAlias) Kind() KindIdentifier {
 	return KindIdentifierAlias
 }
@@ -185,7 +297,7 @@ func (t *TypeMap) Schema() *base.SchemaProxy {
 }
 
 func getTypeObject( //nolint:ireturn
-	schema *base.SchemaProxy, derivedName string, p Plugin,
+	schema *base.SchemaProxy, derivedName string, p Plugin, building map[string]*TypeObject,
 ) (Type, []Type, error) {
 	if schema.IsReference() {
 		derivedName = format.GetNameFromComponentRef(schema.GetReference())
@@ -206,7 +318,15 @@ func getTypeObject( //nolint:ireturn
 		)
 	}
 
-	t, tt, err := NewObject(derivedName, schema, p)
+	// recursive schemas reference an object while it's being built, the
+	// reference resolves to that object which gets its properties once built
+	if schema.IsReference() {
+		if obj, ok := building[refKey(schema)]; ok {
+			return obj, nil, nil
+		}
+	}
+
+	t, tt, err := newObject(derivedName, schema, p, building)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create object type: %w", err)
 	}
@@ -218,10 +338,14 @@ func getTypeObject( //nolint:ireturn
 	return t, append(tt, t), nil
 }
 
-func getTypeArray(schema *base.SchemaProxy, p Plugin) (Type, []Type, error) { //nolint:ireturn
+func getTypeArray( //nolint:ireturn
+	schema *base.SchemaProxy, p Plugin, building map[string]*TypeObject,
+) (Type, []Type, error) {
 	item := schema.Schema().Items.A
 	if item.IsReference() {
-		t, _, err := GetType(item, format.GetNameFromComponentRef(item.GetReference()), p, false)
+		t, _, err := getType(
+			item, format.GetNameFromComponentRef(item.GetReference()), p, false, building,
+		)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to get type for array item: %w", err)
 		}
@@ -244,14 +368,15 @@ func getTypeArray(schema *base.SchemaProxy, p Plugin) (Type, []Type, error) { //
 }
 
 func getTypeEnum( //nolint:ireturn
-	schema *base.SchemaProxy, derivedName string, p Plugin,
+	schema *base.SchemaProxy, derivedName string, p Plugin, isComponent bool,
 ) (Type, []Type, error) {
 	if schema.IsReference() {
 		return &TypeEnum{
-			schema: schema,
-			name:   format.GetNameFromComponentRef(schema.GetReference()),
-			values: nil, // No values for reference types
-			p:      p,
+			schema:    schema,
+			name:      format.GetNameFromComponentRef(schema.GetReference()),
+			values:    nil, // No values for reference types
+			component: true,
+			p:         p,
 		}, nil, nil
 	}
 
@@ -266,10 +391,11 @@ func getTypeEnum( //nolint:ireturn
 	}
 
 	t := &TypeEnum{
-		name:   format.Title(derivedName),
-		schema: schema,
-		values: values,
-		p:      p,
+		name:      format.Title(derivedName),
+		schema:    schema,
+		values:    values,
+		component: isComponent,
+		p:         p,
 	}
 
 	return t, []Type{t}, nil
@@ -280,16 +406,28 @@ func getTypeEnum( //nolint:ireturn
 // if those may need to be defined globally (e.g., nested objects or enums).
 func GetType( //nolint:ireturn
 	schema *base.SchemaProxy, derivedName string, p Plugin, isComponent bool,
+) (Type, []Type, error) {
+	return getType(schema, derivedName, p, isComponent, make(map[string]*TypeObject))
+}
+
+// getType is GetType keeping track of the referenced objects being built, the key
+// is refKey of the reference.
+func getType( //nolint:ireturn
+	schema *base.SchemaProxy,
+	derivedName string,
+	p Plugin,
+	isComponent bool,
+	building map[string]*TypeObject,
 ) (Type, []Type, error) {
 	switch {
 	case schema.Schema().Type[0] == "object":
-		return getTypeObject(schema, derivedName, p)
+		return getTypeObject(schema, derivedName, p, building)
 
 	case schema.Schema().Type[0] == "array":
-		return getTypeArray(schema, p)
+		return getTypeArray(schema, p, building)
 
 	case len(schema.Schema().Enum) > 0:
-		return getTypeEnum(schema, derivedName, p)
+		return getTypeEnum(schema, derivedName, p, isComponent)
 
 	default:
 		s := &TypeScalar{
@@ -315,6 +453,15 @@ func NewObject(
 	name string,
 	schema *base.SchemaProxy,
 	p Plugin,
+) (*TypeObject, []Type, error) {
+	return newObject(name, schema, p, make(map[string]*TypeObject))
+}
+
+func newObject(
+	name string,
+	schema *base.SchemaProxy,
+	p Plugin,
+	building map[string]*TypeObject,
 ) (*TypeObject, []Type, error) {
 	types := make([]Type, 0, 10)           //nolint:mnd
 	properties := make([]*Property, 0, 10) //nolint:mnd
@@ -326,13 +473,18 @@ func NewObject(
 		p:          p,
 	}
 
+	if schema.IsReference() {
+		building[refKey(schema)] = obj
+		defer delete(building, refKey(schema))
+	}
+
 	for propPairs := schema.Schema().Properties.First(); propPairs != nil; propPairs = propPairs.Next() {
 		propName := propPairs.Key()
 		prop := propPairs.Value()
 
 		derivedName := name + format.Title(propName)
 
-		typ, tt, err := GetType(prop, derivedName, p, false)
+		typ, tt, err := getType(prop, derivedName, p, false, building)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to get type for property %s: %w", propName, err)
 		}
@@ -343,6 +495,7 @@ func NewObject(
 			name:   propName,
 			Parent: obj,
 			Type:   typ,
+			cyclic: false,
 			p:      p,
 		}
 		properties = append(properties, property)
@@ -0,0 +1,57 @@
+package typescript
+
+import (
+	"fmt"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+func isDateFormat(schema *base.Schema) bool {
+	return schema.Format == "date-time" || schema.Format == "date"
+}
+
+// responseHeaderType returns the TypeScript type of the parsed header value.
+func responseHeaderType(header *processor.ResponseHeader) string {
+	schema := header.Type.Schema().Schema()
+	if len(schema.Type) > 0 && schema.Type[0] == "string" && isDateFormat(schema) {
+		return "Date"
+	}
+
+	return header.Type.Name()
+}
+
+func parseScalar(schema *base.Schema, value string) string {
+	if len(schema.Type) == 0 {
+		return value
+	}
+
+	switch schema.Type[0] {
+	case "integer", "number":
+		return fmt.Sprintf("Number(%s)", value)
+	case "boolean":
+		return fmt.Sprintf("%s === \"true\"", value)
+	case "string":
+		if isDateFormat(schema) {
+			return fmt.Sprintf("new Date(%s)", value)
+		}
+	}
+
+	return value
+}
+
+// parseResponseHeader returns a TypeScript expression that converts the raw string
+// `value` of the header into the type returned by responseHeaderType.
+func parseResponseHeader(header *processor.ResponseHeader, value string) string {
+	switch t := header.Type.(type) {
+	case *processor.TypeArray:
+		return fmt.Sprintf(
+			"%s.split(\",\").map((v) => %s)",
+			value, parseScalar(t.Item.Schema().Schema(), "v.trim()"),
+		)
+	case *processor.TypeEnum:
+		return fmt.Sprintf("%s as %s", value, t.Name())
+	default:
+		return parseScalar(header.Type.Schema().Schema(), value)
+	}
+}
@@ -0,0 +1,169 @@
+package typescript
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func parameterSchemaType(param *processor.Parameter) string {
+	schema := param.Parameter.Schema
+	if schema == nil || schema.Schema() == nil || len(schema.Schema().Type) == 0 {
+		return ""
+	}
+
+	return schema.Schema().Type[0]
+}
+
+// encodeFunction returns the function percent-encoding the values of param.
+// Parameters allowing reserved characters use the encodeReserved helper of the
+// client as encodeURI would leave &, =, # and + unencoded.
+func encodeFunction(param *processor.Parameter) string {
+	if param.AllowReserved() {
+		return "encodeReserved"
+	}
+
+	return "encodeURIComponent"
+}
+
+// hasReservedQueryParameters returns true if any of the methods has a query
+// parameter allowing reserved characters, i.e. the client needs encodeReserved.
+func hasReservedQueryParameters(methods []*processor.Method) bool {
+	for _, m := range methods {
+		for _, param := range m.QueryParameters() {
+			if param.AllowReserved() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func delimiter(style processor.ParameterStyle) string {
+	switch style { //nolint:exhaustive
+	case processor.ParameterStyleSpaceDelimited:
+		return "%20"
+	case processor.ParameterStylePipeDelimited:
+		return "|"
+	default:
+		return ","
+	}
+}
+
+// serializeQueryParameter returns a TypeScript expression that serializes the
+// variable `value` into the query string fragment for param according to its
+// style, explode and allowReserved settings.
+func serializeQueryParameter(param *processor.Parameter, value string) string {
+	key := url.QueryEscape(param.WireName())
+	enc := encodeFunction(param)
+
+	if param.IsContent() {
+		return fmt.Sprintf("`%s=${%s(JSON.stringify(%s))}`", key, enc, value)
+	}
+
+	style := param.Style()
+
+	switch parameterSchemaType(param) {
+	case "array":
+		if param.Explode() {
+			return fmt.Sprintf(
+				"%s.map((v) => `%s=${%s(String(v))}`).join(\"&\")", value, key, enc,
+			)
+		}
+
+		return fmt.Sprintf(
+			"`%s=${%s.map((v) => %s(String(v))).join(\"%s\")}`",
+			key, value, enc, delimiter(style),
+		)
+	case "object":
+		switch {
+		case style == processor.ParameterStyleDeepObject:
+			return fmt.Sprintf(
+				"Object.entries(%s).map(([k, v]) => `%s[${%s(k)}]=${%s(String(v))}`).join(\"&\")",
+				value, key, enc, enc,
+			)
+		case style == processor.ParameterStyleForm && param.Explode():
+			return fmt.Sprintf(
+				"Object.entries(%s).map(([k, v]) => `${%s(k)}=${%s(String(v))}`).join(\"&\")",
+				value, enc, enc,
+			)
+		default:
+			return fmt.Sprintf(
+				"`%s=${Object.entries(%s).flatMap(([k, v]) => [%s(k), %s(String(v))]).join(\"%s\")}`",
+				key, value, enc, enc, delimiter(style),
+			)
+		}
+	default:
+		return fmt.Sprintf("`%s=${%s(String(%s))}`", key, enc, value)
+	}
+}
+
+func escapeTemplateLiteral(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "`", "\\`")
+
+	return strings.ReplaceAll(s, "${", "\\${")
+}
+
+func prefixExpression(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("\"%s\" + ", prefix)
+}
+
+// serializePathParameter returns a TypeScript expression that serializes the path
+// parameter according to its style (simple, label or matrix) and explode setting.
+// Values are always percent-encoded so they can't alter the route.
+func serializePathParameter(param *processor.Parameter) string {
+	name := param.Name()
+	key := url.PathEscape(param.WireName())
+
+	var prefix, sep string
+
+	switch param.Style() { //nolint:exhaustive
+	case processor.ParameterStyleLabel:
+		prefix, sep = ".", ","
+		if param.Explode() {
+			sep = "."
+		}
+	case processor.ParameterStyleMatrix:
+		prefix, sep = ";"+key+"=", ","
+		if param.Explode() {
+			sep = ";" + key + "="
+		}
+	default:
+		prefix, sep = "", ","
+	}
+
+	switch parameterSchemaType(param) {
+	case "array":
+		return fmt.Sprintf(
+			"%s%s.map((v) => encodeURIComponent(String(v))).join(\"%s\")",
+			prefixExpression(prefix), name, sep,
+		)
+	case "object":
+		if !param.Explode() {
+			return fmt.Sprintf(
+				"%sObject.entries(%s).flatMap(([k, v]) => [encodeURIComponent(k), encodeURIComponent(String(v))]).join(\"%s\")", //nolint:lll
+				prefixExpression(prefix), name, sep,
+			)
+		}
+
+		// exploded objects use the keys of the object instead of the parameter name
+		if param.Style() == processor.ParameterStyleMatrix {
+			prefix, sep = ";", ";"
+		}
+
+		return fmt.Sprintf(
+			"%sObject.entries(%s).map(([k, v]) => `${encodeURIComponent(k)}=${encodeURIComponent(String(v))}`).join(\"%s\")", //nolint:lll
+			prefixExpression(prefix), name, sep,
+		)
+	default:
+		return fmt.Sprintf("%sencodeURIComponent(String(%s))", prefixExpression(prefix), name)
+	}
+}
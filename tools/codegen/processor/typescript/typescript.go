@@ -15,7 +15,13 @@ const extCustomType = "x-ts-type"
 //go:embed templates/*.tmpl
 var templatesFS embed.FS
 
-type Typescript struct{}
+type Typescript struct {
+	// Zod generates a Zod schema for every type and a client option to validate responses
+	Zod bool
+	// Validators generates dependency-free validate<Type> functions and a client
+	// option to validate request bodies
+	Validators bool
+}
 
 func (t *Typescript) GetTemplates() fs.FS {
 	return templatesFS
@@ -31,7 +37,19 @@ func quotePropertyIfNeeded(name string) string {
 
 func (t *Typescript) GetFuncMap() map[string]any {
 	return map[string]any{
-		"quotePropertyIfNeeded": quotePropertyIfNeeded,
+		"quotePropertyIfNeeded":      quotePropertyIfNeeded,
+		"serializeQueryParameter":    serializeQueryParameter,
+		"hasReservedQueryParameters": hasReservedQueryParameters,
+		"responseHeaderType":         responseHeaderType,
+		"parseResponseHeader":        parseResponseHeader,
+		"zod":                        func() bool { return t.Zod },
+		"zodDefinition":              zodDefinition,
+		"zodCyclicTypes":             zodCyclicTypes,
+		"zodResponseSchemas":         zodResponseSchemas,
+		"zodValidatesResponses":      zodValidatesResponses,
+		"validators":                 func() bool { return t.Validators },
+		"validatorChecker":           validatorChecker,
+		"validatorDefinition":        validatorDefinition,
 	}
 }
 
@@ -39,6 +57,10 @@ func (t *Typescript) TypeObjectName(name string) string {
 	return format.ToCamelCase(name)
 }
 
+func (t *Typescript) TypeInputName(name string) string {
+	return name + "Input"
+}
+
 func (t *Typescript) TypeScalarName(scalar *processor.TypeScalar) string {
 	switch scalar.Schema().Schema().Type[0] {
 	case "integer":
@@ -89,8 +111,18 @@ func (t *Typescript) MethodName(name string) string {
 	return format.AntiTitle(format.ToCamelCase(name))
 }
 
-func (t *Typescript) MethodPath(name string) string {
-	return strings.ReplaceAll(name, "{", "${")
+func (t *Typescript) MethodPath(segments []*processor.PathSegment) string {
+	var b strings.Builder
+
+	for _, segment := range segments {
+		if segment.IsParameter() {
+			b.WriteString("${" + serializePathParameter(segment.Parameter) + "}")
+		} else {
+			b.WriteString(escapeTemplateLiteral(segment.Literal))
+		}
+	}
+
+	return b.String()
 }
 
 func (t *Typescript) ParameterName(name string) string {
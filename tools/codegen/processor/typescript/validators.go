@@ -0,0 +1,154 @@
+package typescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+func jsObject(fields []string) string {
+	if len(fields) == 0 {
+		return "{}"
+	}
+
+	return "{ " + strings.Join(fields, ", ") + " }"
+}
+
+func validatorStringOptions(c *processor.Constraints) string {
+	opts := make([]string, 0, 4) //nolint:mnd
+
+	if c.MinLength != nil {
+		opts = append(opts, fmt.Sprintf("minLength: %d", *c.MinLength))
+	}
+
+	if c.MaxLength != nil {
+		opts = append(opts, fmt.Sprintf("maxLength: %d", *c.MaxLength))
+	}
+
+	if c.Pattern != "" {
+		opts = append(opts, "pattern: "+jsString(c.Pattern))
+	}
+
+	if c.Format != "" {
+		opts = append(opts, "format: "+jsString(c.Format))
+	}
+
+	return jsObject(opts)
+}
+
+func validatorNumberOptions(c *processor.Constraints, integer bool) string {
+	opts := make([]string, 0, 4) //nolint:mnd
+
+	if integer {
+		opts = append(opts, "integer: true")
+	}
+
+	if c.Minimum != nil {
+		opts = append(opts, "minimum: "+formatNumber(*c.Minimum))
+		if c.ExclusiveMinimum {
+			opts = append(opts, "exclusiveMinimum: true")
+		}
+	}
+
+	if c.Maximum != nil {
+		opts = append(opts, "maximum: "+formatNumber(*c.Maximum))
+		if c.ExclusiveMaximum {
+			opts = append(opts, "exclusiveMaximum: true")
+		}
+	}
+
+	return jsObject(opts)
+}
+
+func validatorScalar(t processor.Type) string {
+	c := processor.GetConstraints(t)
+
+	switch processor.ScalarType(t) {
+	case "string":
+		if c.Format == "binary" {
+			return "checkBlob"
+		}
+
+		return fmt.Sprintf("(v, p, i) => checkString(v, p, i, %s)", validatorStringOptions(c))
+	case "integer":
+		return fmt.Sprintf("(v, p, i) => checkNumber(v, p, i, %s)", validatorNumberOptions(c, true))
+	case "number":
+		return fmt.Sprintf("(v, p, i) => checkNumber(v, p, i, %s)", validatorNumberOptions(c, false))
+	case "boolean":
+		return "checkBoolean"
+	default:
+		return "checkAny"
+	}
+}
+
+func nullableChecker(t processor.Type, checker string) string {
+	if processor.GetConstraints(t).Nullable {
+		return fmt.Sprintf("nullable(%s)", checker)
+	}
+
+	return checker
+}
+
+// validatorChecker returns a TypeScript expression of type Checker that validates
+// a value of the given type. Named types are validated by their own checker.
+func validatorChecker(t processor.Type) string {
+	switch t := t.(type) {
+	case *processor.TypeObject, *processor.TypeEnum, *processor.TypeAlias:
+		return "check" + t.Name()
+	case *processor.TypeArray:
+		c := processor.GetConstraints(t)
+
+		opts := make([]string, 0, 2) //nolint:mnd
+		if c.MinItems != nil {
+			opts = append(opts, fmt.Sprintf("minItems: %d", *c.MinItems))
+		}
+
+		if c.MaxItems != nil {
+			opts = append(opts, fmt.Sprintf("maxItems: %d", *c.MaxItems))
+		}
+
+		return nullableChecker(t, fmt.Sprintf(
+			"(v, p, i) => checkArray(v, p, i, %s, %s)",
+			jsObject(opts), validatorChecker(t.Item),
+		))
+	case *processor.TypeMap:
+		return "checkRecord"
+	default:
+		return nullableChecker(t, validatorScalar(t))
+	}
+}
+
+func validatorObject(t *processor.TypeObject) string {
+	required := make([]string, 0, len(t.Properties()))
+	properties := make([]string, 0, len(t.Properties()))
+
+	for _, prop := range t.Properties() {
+		if prop.Required() {
+			required = append(required, jsString(prop.Name()))
+		}
+
+		properties = append(properties, fmt.Sprintf(
+			"    %s: %s,", quotePropertyIfNeeded(prop.Name()), validatorChecker(prop.Type),
+		))
+	}
+
+	return fmt.Sprintf(
+		"(v, p, i) => checkObject(v, p, i, [%s], {\n%s\n  })",
+		strings.Join(required, ", "), strings.Join(properties, "\n"),
+	)
+}
+
+// validatorDefinition returns the checker declared for a type in the types section.
+func validatorDefinition(t processor.Type) string {
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return nullableChecker(t, validatorObject(t))
+	case *processor.TypeEnum:
+		return fmt.Sprintf("(v, p, i) => checkEnum(v, p, i, [%s])", strings.Join(t.Values(), ", "))
+	case *processor.TypeAlias:
+		return validatorChecker(t.Alias())
+	default:
+		return validatorChecker(t)
+	}
+}
@@ -0,0 +1,234 @@
+package typescript
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/processor"
+)
+
+// jsString returns s as a JavaScript string literal.
+//
+//nolint:gochecknoglobals
+var jsString = processor.StringLiteral{Interpolation: "", BracedUnicode: false, SingleQuoted: false}.Quote
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func zodStringFormat(format string) string {
+	switch format {
+	case "email":
+		return ".email()"
+	case "uri", "url":
+		return ".url()"
+	case "uuid":
+		return ".uuid()"
+	case "date-time":
+		return ".datetime({ offset: true })"
+	default:
+		return ""
+	}
+}
+
+func zodString(c *processor.Constraints) string {
+	var b strings.Builder
+
+	b.WriteString("z.string()")
+	b.WriteString(zodStringFormat(c.Format))
+
+	if c.Pattern != "" {
+		fmt.Fprintf(&b, ".regex(new RegExp(%s, \"u\"))", jsString(c.Pattern))
+	}
+
+	if c.MinLength != nil {
+		fmt.Fprintf(&b, ".min(%d)", *c.MinLength)
+	}
+
+	if c.MaxLength != nil {
+		fmt.Fprintf(&b, ".max(%d)", *c.MaxLength)
+	}
+
+	return b.String()
+}
+
+func zodNumber(c *processor.Constraints, integer bool) string {
+	var b strings.Builder
+
+	b.WriteString("z.number()")
+
+	if integer {
+		b.WriteString(".int()")
+	}
+
+	if c.Minimum != nil {
+		if c.ExclusiveMinimum {
+			fmt.Fprintf(&b, ".gt(%s)", formatNumber(*c.Minimum))
+		} else {
+			fmt.Fprintf(&b, ".gte(%s)", formatNumber(*c.Minimum))
+		}
+	}
+
+	if c.Maximum != nil {
+		if c.ExclusiveMaximum {
+			fmt.Fprintf(&b, ".lt(%s)", formatNumber(*c.Maximum))
+		} else {
+			fmt.Fprintf(&b, ".lte(%s)", formatNumber(*c.Maximum))
+		}
+	}
+
+	return b.String()
+}
+
+func zodScalar(t processor.Type) string {
+	c := processor.GetConstraints(t)
+
+	var s string
+
+	switch processor.ScalarType(t) {
+	case "string":
+		if c.Format == "binary" {
+			s = "z.instanceof(Blob)"
+		} else {
+			s = zodString(c)
+		}
+	case "integer":
+		s = zodNumber(c, true)
+	case "number":
+		s = zodNumber(c, false)
+	case "boolean":
+		s = "z.boolean()"
+	default:
+		s = "z.unknown()"
+	}
+
+	if c.Nullable {
+		s += ".nullable()"
+	}
+
+	return s
+}
+
+// zodReference returns the Zod schema expression used when the type is referenced
+// from another schema. Named types are referenced lazily so declaration order
+// doesn't matter.
+func zodReference(t processor.Type) string {
+	switch t := t.(type) {
+	case *processor.TypeObject, *processor.TypeEnum, *processor.TypeAlias:
+		return fmt.Sprintf("z.lazy(() => %sSchema)", t.Name())
+	case *processor.TypeArray:
+		c := processor.GetConstraints(t)
+
+		s := fmt.Sprintf("z.array(%s)", zodReference(t.Item))
+		if c.MinItems != nil {
+			s += fmt.Sprintf(".min(%d)", *c.MinItems)
+		}
+
+		if c.MaxItems != nil {
+			s += fmt.Sprintf(".max(%d)", *c.MaxItems)
+		}
+
+		if c.Nullable {
+			s += ".nullable()"
+		}
+
+		return s
+	case *processor.TypeMap:
+		return "z.record(z.string(), z.unknown())"
+	default:
+		return zodScalar(t)
+	}
+}
+
+func zodEnum(t *processor.TypeEnum) string {
+	values := t.Values()
+
+	literals := make([]string, len(values))
+	for i, v := range values {
+		literals[i] = fmt.Sprintf("z.literal(%s)", v)
+	}
+
+	switch len(literals) {
+	case 0:
+		return "z.never()"
+	case 1:
+		return literals[0]
+	default:
+		return fmt.Sprintf("z.union([%s])", strings.Join(literals, ", "))
+	}
+}
+
+func zodObject(t *processor.TypeObject) string {
+	var b strings.Builder
+
+	b.WriteString("z.object({\n")
+
+	for _, prop := range t.Properties() {
+		s := zodReference(prop.Type)
+		if !prop.Required() {
+			s += ".optional()"
+		}
+
+		fmt.Fprintf(&b, "  %s: %s,\n", quotePropertyIfNeeded(prop.Name()), s)
+	}
+
+	b.WriteString("})")
+
+	return b.String()
+}
+
+// zodDefinition returns the Zod schema declared for a type in the types section.
+func zodDefinition(t processor.Type) string {
+	switch t := t.(type) {
+	case *processor.TypeObject:
+		return zodObject(t)
+	case *processor.TypeEnum:
+		return zodEnum(t)
+	case *processor.TypeAlias:
+		return zodReference(t.Alias())
+	default:
+		return zodReference(t)
+	}
+}
+
+// zodCyclicTypes returns the names of the types in a cycle. Their schemas are
+// annotated with the TypeScript type as their type can't be inferred from an
+// initializer referencing them.
+func zodCyclicTypes(groups []processor.TypeGroup) map[string]bool {
+	cyclic := make(map[string]bool)
+
+	for _, group := range groups {
+		if !group.Cyclic {
+			continue
+		}
+
+		for _, t := range group.Types {
+			cyclic[t.Name()] = true
+		}
+	}
+
+	return cyclic
+}
+
+// zodValidatesResponses returns true if m has a successful response with a JSON
+// body to validate.
+func zodValidatesResponses(m *processor.Method) bool {
+	return slices.ContainsFunc(m.SuccessResponses(), func(r *processor.SuccessResponse) bool {
+		return r.Type != nil
+	})
+}
+
+// zodResponseSchemas returns an object literal mapping each successful response
+// code with a JSON body to its Zod schema.
+func zodResponseSchemas(m *processor.Method) string {
+	entries := make([]string, 0, len(m.Responses))
+	for _, r := range m.SuccessResponses() {
+		if r.Type != nil {
+			entries = append(entries, fmt.Sprintf("%s: %s", r.Code, zodReference(r.Type)))
+		}
+	}
+
+	return "{ " + strings.Join(entries, ", ") + " }"
+}
@@ -0,0 +1,182 @@
+package processor
+
+/*
+Schemas can flag properties as readOnly (only sent by the server) or writeOnly (only sent
+by the client). When an object, or any object nested inside it, has such properties we
+derive an input view of it which is used for request bodies and parameters:
+
+- The output view (the original type) drops writeOnly properties.
+- The input view drops readOnly properties and is named using Plugin.TypeInputName.
+
+Objects defined inline in a request body are only used as input so they are turned into
+input views in place, keeping their name.
+*/
+
+type inputViews struct {
+	plugin Plugin
+	// key is the name of the original object
+	cache map[string]*TypeObject
+	// input views in the order they were created (nested types first)
+	types []*TypeObject
+}
+
+func newInputViews(plugin Plugin) *inputViews {
+	return &inputViews{
+		plugin: plugin,
+		cache:  make(map[string]*TypeObject),
+		types:  make([]*TypeObject, 0, 10), //nolint:mnd
+	}
+}
+
+func needsInputView(t Type, visited map[string]struct{}) bool {
+	switch t := t.(type) {
+	case *TypeObject:
+		if _, ok := visited[t.name]; ok {
+			return false
+		}
+
+		visited[t.name] = struct{}{}
+
+		for _, prop := range t.properties {
+			if prop.ReadOnly() || prop.WriteOnly() || needsInputView(prop.Type, visited) {
+				return true
+			}
+		}
+
+		return false
+	case *TypeArray:
+		return needsInputView(t.Item, visited)
+	default:
+		return false
+	}
+}
+
+// input returns the input view of the given type or the type itself if
+// it doesn't need one.
+func (v *inputViews) input(t Type) Type { //nolint:ireturn
+	switch t := t.(type) {
+	case *TypeObject:
+		if t.input || !needsInputView(t, make(map[string]struct{})) {
+			return t
+		}
+
+		if in, ok := v.cache[t.name]; ok {
+			return in
+		}
+
+		in := &TypeObject{
+			name:       v.plugin.TypeInputName(t.name),
+			schema:     t.schema,
+			properties: make([]*Property, 0, len(t.properties)),
+			input:      true,
+			p:          t.p,
+		}
+		v.cache[t.name] = in
+
+		for _, prop := range t.properties {
+			if prop.ReadOnly() {
+				continue
+			}
+
+			in.properties = append(in.properties, &Property{
+				name:   prop.name,
+				Parent: in,
+				Type:   v.input(prop.Type),
+				cyclic: false,
+				p:      prop.p,
+			})
+		}
+
+		v.types = append(v.types, in)
+
+		return in
+	case *TypeArray:
+		item := v.input(t.Item)
+		if item == t.Item {
+			return t
+		}
+
+		return &TypeArray{
+			schema: t.schema,
+			Item:   item,
+			p:      t.p,
+		}
+	default:
+		return t
+	}
+}
+
+// inline turns an object defined inline in a request into its own input view. As
+// inline objects aren't shared with responses there is no need to derive a new type.
+func (v *inputViews) inline(t *TypeObject) {
+	if t.input || !needsInputView(t, make(map[string]struct{})) {
+		return
+	}
+
+	properties := make([]*Property, 0, len(t.properties))
+
+	for _, prop := range t.properties {
+		if prop.ReadOnly() {
+			continue
+		}
+
+		if obj, ok := prop.Type.(*TypeObject); ok && !obj.schema.IsReference() {
+			v.inline(obj)
+		} else {
+			prop.Type = v.input(prop.Type)
+		}
+
+		properties = append(properties, prop)
+	}
+
+	t.properties = properties
+	t.input = true
+}
+
+// apply replaces the types of the request bodies and parameters of the
+// methods with their input views.
+func (v *inputViews) apply(methods []*Method) {
+	for _, m := range methods {
+		for media, t := range m.Bodies {
+			if obj, ok := t.(*TypeObject); ok && !obj.schema.IsReference() {
+				v.inline(obj)
+				continue
+			}
+
+			m.Bodies[media] = v.input(t)
+		}
+
+		for _, param := range m.Parameters {
+			param.Type = v.input(param.Type)
+		}
+	}
+}
+
+// insert adds the input views to types, placing each one right after its
+// output view when the latter is part of types.
+func (v *inputViews) insert(types []Type) []Type {
+	inserted := make(map[*TypeObject]struct{}, len(v.types))
+	result := make([]Type, 0, len(types)+len(v.types))
+
+	for _, t := range types {
+		result = append(result, t)
+
+		obj, ok := t.(*TypeObject)
+		if !ok || obj.input {
+			continue
+		}
+
+		if in, ok := v.cache[obj.name]; ok {
+			result = append(result, in)
+			inserted[in] = struct{}{}
+		}
+	}
+
+	for _, in := range v.types {
+		if _, ok := inserted[in]; !ok {
+			result = append(result, in)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,536 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/format"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrCircularReference = errors.New("circular reference")
+	ErrRemoteReference   = errors.New("remote references are not supported")
+	ErrUnresolvedRef     = errors.New("unresolved reference")
+)
+
+// httpMethods are the keys of path items holding operations.
+var httpMethods = []string{ //nolint:gochecknoglobals
+	"get", "put", "post", "delete", "options", "head", "patch", "trace",
+}
+
+// BundleOptions controls how Bundle produces the document.
+type BundleOptions struct {
+	// Dereference replaces every reference, internal ones included, with the
+	// value it points to. Documents with recursive schemas can't be dereferenced.
+	Dereference bool
+	// ExcludeTag drops the operations tagged with it, e.g. excludeme. Paths
+	// left without operations are dropped as well.
+	ExcludeTag string
+}
+
+type schemaContext int
+
+const (
+	// contextOther is any value that isn't known to be a schema
+	contextOther schemaContext = iota
+	// contextSchema is a schema
+	contextSchema
+	// contextSchemaMap is a mapping whose values are schemas, e.g. properties
+	contextSchemaMap
+	// contextSchemaList is a sequence of schemas, e.g. allOf
+	contextSchemaList
+)
+
+type bundler struct {
+	root    string
+	baseDir string
+	doc     *yaml.Node
+	files   map[string]*yaml.Node
+	hoisted map[string]string
+	stack   []string
+	options BundleOptions
+}
+
+// Bundle reads the OpenAPI document at path and returns a single self-contained
+// document. References to other files are resolved: schemas and anything pointing
+// into the components of another file are moved to the components of the
+// document, anything else is inlined. References within the document are kept
+// unless options.Dereference is set.
+func Bundle(path string, options BundleOptions) (*yaml.Node, error) {
+	return BundleWithConfig(path, Config{BaseDir: "", Overlay: ""}, options)
+}
+
+// BundleWithConfig is like Bundle but resolves the document as LoadWithConfig does.
+func BundleWithConfig(path string, config Config, options BundleOptions) (*yaml.Node, error) {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path of OpenAPI file: %w", err)
+	}
+
+	b := &bundler{
+		root:    root,
+		baseDir: filepath.Dir(root),
+		doc:     nil,
+		files:   make(map[string]*yaml.Node),
+		hoisted: make(map[string]string),
+		stack:   make([]string, 0),
+		options: options,
+	}
+
+	if config.BaseDir != "" {
+		if b.baseDir, err = filepath.Abs(config.BaseDir); err != nil {
+			return nil, fmt.Errorf("failed to resolve base directory: %w", err)
+		}
+	}
+
+	doc, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Overlay != "" {
+		overlay, err := LoadOverlay(config.Overlay)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := overlay.Apply(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	b.files[root] = doc
+	b.doc = doc
+
+	if options.ExcludeTag != "" {
+		dropTaggedOperations(doc, options.ExcludeTag)
+	}
+
+	if !options.Dereference {
+		if err := b.bundleComponents(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := b.walk(doc, root, contextOther, ""); err != nil {
+		return nil, err
+	}
+
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{doc}}, nil //nolint:exhaustruct
+}
+
+func readFile(path string) (*yaml.Node, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		return node.Content[0], nil
+	}
+
+	return &node, nil
+}
+
+func (b *bundler) file(path string) (*yaml.Node, error) {
+	if node, ok := b.files[path]; ok {
+		return node, nil
+	}
+
+	node, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b.files[path] = node
+
+	return node, nil
+}
+
+// mappingValue returns the value of the key in the mapping or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// ensureMapping returns the value of the key in the mapping, adding an empty
+// mapping if the key is missing.
+func ensureMapping(node *yaml.Node, key string) *yaml.Node {
+	if value := mappingValue(node, key); value != nil {
+		return value
+	}
+
+	value := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"} //nolint:exhaustruct
+	node.Content = append(
+		node.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, //nolint:exhaustruct
+		value,
+	)
+
+	return value
+}
+
+func copyNode(node *yaml.Node) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+
+	c := *node
+	c.Content = make([]*yaml.Node, len(node.Content))
+
+	for i, child := range node.Content {
+		c.Content[i] = copyNode(child)
+	}
+
+	return &c
+}
+
+// resolvePointer returns the node the JSON pointer points to in the document.
+func resolvePointer(doc *yaml.Node, pointer string) (*yaml.Node, bool) {
+	node := doc
+
+	for _, segment := range strings.Split(pointer, "/") {
+		if segment == "" {
+			continue
+		}
+
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+
+		switch node.Kind { //nolint:exhaustive
+		case yaml.MappingNode:
+			if node = mappingValue(node, segment); node == nil {
+				return nil, false
+			}
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return nil, false
+			}
+
+			node = node.Content[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return node, true
+}
+
+// reference returns the ref of the node if it's a reference object.
+func reference(node *yaml.Node) (string, bool) {
+	ref := mappingValue(node, "$ref")
+	if ref == nil || ref.Kind != yaml.ScalarNode {
+		return "", false
+	}
+
+	return ref.Value, true
+}
+
+// target returns the absolute path of the file and the JSON pointer a ref found
+// in file points to. Refs in the document are relative to the base directory and
+// refs in other files to the directory of the file.
+func (b *bundler) target(ref, file string) (string, string, error) {
+	refFile, pointer, _ := strings.Cut(ref, "#")
+
+	if strings.Contains(refFile, "://") {
+		return "", "", fmt.Errorf("%w: %s", ErrRemoteReference, ref)
+	}
+
+	if refFile == "" {
+		return file, pointer, nil
+	}
+
+	dir := filepath.Dir(file)
+	if file == b.root {
+		dir = b.baseDir
+	}
+
+	return filepath.Join(dir, filepath.FromSlash(refFile)), pointer, nil
+}
+
+// component returns the kind and the name of the component the external ref
+// should be moved to, or false if it should be inlined.
+func component(ref, pointer string, ctx schemaContext) (string, string, bool) {
+	segments := strings.Split(strings.Trim(pointer, "/"), "/")
+	if len(segments) == 3 && segments[0] == "components" { //nolint:mnd
+		return segments[1], format.GetNameFromComponentRef(ref), true
+	}
+
+	if ctx == contextSchema {
+		return "schemas", format.GetNameFromComponentRef(ref), true
+	}
+
+	return "", "", false
+}
+
+// uniqueName returns name, or name followed by a number if the kind of components
+// already has a component with that name.
+func (b *bundler) uniqueName(kind, name string) string {
+	components := mappingValue(mappingValue(b.doc, "components"), kind)
+
+	unique := name
+	for i := 2; mappingValue(components, unique) != nil; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+
+	return unique
+}
+
+// resolve returns a bundled copy of the value the ref points to.
+func (b *bundler) resolve(file, pointer string, ctx schemaContext) (*yaml.Node, error) {
+	key := file + "#" + pointer
+	if slices.Contains(b.stack, key) {
+		return nil, fmt.Errorf("%w: %s", ErrCircularReference, key)
+	}
+
+	doc, err := b.file(file)
+	if err != nil {
+		return nil, err
+	}
+
+	node, ok := resolvePointer(doc, pointer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvedRef, key)
+	}
+
+	b.stack = append(b.stack, key)
+	defer func() { b.stack = b.stack[:len(b.stack)-1] }()
+
+	node = copyNode(node)
+	if err := b.walk(node, file, ctx, ""); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// bundleComponents replaces the components of the document that reference other
+// files with the values they reference, so the components keep their names.
+func (b *bundler) bundleComponents() error {
+	components := mappingValue(b.doc, "components")
+	if components == nil || components.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(components.Content); i += 2 {
+		kind, entries := components.Content[i].Value, components.Content[i+1]
+		if entries.Kind != yaml.MappingNode {
+			continue
+		}
+
+		for j := 0; j+1 < len(entries.Content); j += 2 {
+			ref, ok := reference(entries.Content[j+1])
+			if !ok || strings.HasPrefix(ref, "#") {
+				continue
+			}
+
+			file, pointer, err := b.target(ref, b.root)
+			if err != nil {
+				return err
+			}
+
+			b.hoisted[file+"#"+pointer] = "#/components/" + kind + "/" + entries.Content[j].Value
+
+			ctx := contextOther
+			if kind == "schemas" {
+				ctx = contextSchema
+			}
+
+			node, err := b.resolve(file, pointer, ctx)
+			if err != nil {
+				return err
+			}
+
+			entries.Content[j+1] = node
+		}
+	}
+
+	return nil
+}
+
+// bundleRef rewrites or replaces the reference object node found in file.
+func (b *bundler) bundleRef(node *yaml.Node, ref, file string, ctx schemaContext) error {
+	targetFile, pointer, err := b.target(ref, file)
+	if err != nil {
+		return err
+	}
+
+	refNode := mappingValue(node, "$ref")
+
+	switch {
+	case b.options.Dereference:
+		resolved, err := b.resolve(targetFile, pointer, ctx)
+		if err != nil {
+			return err
+		}
+
+		*node = *resolved
+
+		return nil
+	case targetFile == b.root:
+		refNode.Value = "#" + pointer
+		return nil
+	}
+
+	key := targetFile + "#" + pointer
+	if local, ok := b.hoisted[key]; ok {
+		refNode.Value = local
+		return nil
+	}
+
+	kind, name, ok := component(ref, pointer, ctx)
+	if !ok {
+		resolved, err := b.resolve(targetFile, pointer, ctx)
+		if err != nil {
+			return err
+		}
+
+		*node = *resolved
+
+		return nil
+	}
+
+	name = b.uniqueName(kind, name)
+	local := "#/components/" + kind + "/" + name
+	b.hoisted[key] = local
+
+	// the component is added before resolving it so recursive refs point to it
+	components := ensureMapping(ensureMapping(b.doc, "components"), kind)
+	placeholder := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"} //nolint:exhaustruct
+	components.Content = append(
+		components.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}, //nolint:exhaustruct
+		placeholder,
+	)
+
+	if kind != "schemas" {
+		ctx = contextOther
+	}
+
+	resolved, err := b.resolve(targetFile, pointer, ctx)
+	if err != nil {
+		return err
+	}
+
+	*placeholder = *resolved
+	refNode.Value = local
+
+	return nil
+}
+
+// childContext returns the context of the value of the key in a mapping.
+func childContext(ctx schemaContext, key, parentKey string) schemaContext {
+	if ctx == contextSchemaMap {
+		return contextSchema
+	}
+
+	switch key {
+	case "schema", "items", "additionalProperties", "not", "contains", "propertyNames", "if", "then", "else":
+		return contextSchema
+	case "properties", "patternProperties", "$defs", "definitions":
+		return contextSchemaMap
+	case "allOf", "oneOf", "anyOf", "prefixItems":
+		return contextSchemaList
+	case "schemas":
+		if parentKey == "components" {
+			return contextSchemaMap
+		}
+	}
+
+	return contextOther
+}
+
+// walk bundles the references found in node, which was read from file.
+func (b *bundler) walk(node *yaml.Node, file string, ctx schemaContext, key string) error {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.MappingNode:
+		if ref, ok := reference(node); ok {
+			return b.bundleRef(node, ref, file, ctx)
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			childKey := node.Content[i].Value
+			if childKey == "example" {
+				continue
+			}
+
+			if err := b.walk(node.Content[i+1], file, childContext(ctx, childKey, key), childKey); err != nil {
+				return err
+			}
+		}
+	case yaml.SequenceNode:
+		for _, child := range node.Content {
+			childCtx := contextOther
+			if ctx == contextSchemaList {
+				childCtx = contextSchema
+			}
+
+			if err := b.walk(child, file, childCtx, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// dropTaggedOperations removes the operations tagged with tag and the paths left
+// without operations.
+func dropTaggedOperations(doc *yaml.Node, tag string) {
+	paths := mappingValue(doc, "paths")
+	if paths == nil || paths.Kind != yaml.MappingNode {
+		return
+	}
+
+	keptPaths := make([]*yaml.Node, 0, len(paths.Content))
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		item := paths.Content[i+1]
+		kept := make([]*yaml.Node, 0, len(item.Content))
+		operations := 0
+
+		for j := 0; j+1 < len(item.Content); j += 2 {
+			if !slices.Contains(httpMethods, item.Content[j].Value) {
+				kept = append(kept, item.Content[j], item.Content[j+1])
+				continue
+			}
+
+			if tags := mappingValue(item.Content[j+1], "tags"); tags != nil &&
+				slices.ContainsFunc(tags.Content, func(n *yaml.Node) bool { return n.Value == tag }) {
+				continue
+			}
+
+			operations++
+
+			kept = append(kept, item.Content[j], item.Content[j+1])
+		}
+
+		item.Content = kept
+
+		if operations > 0 || mappingValue(item, "$ref") != nil {
+			keptPaths = append(keptPaths, paths.Content[i], item)
+		}
+	}
+
+	paths.Content = keptPaths
+}
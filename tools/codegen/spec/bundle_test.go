@@ -0,0 +1,84 @@
+package spec_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundle(t *testing.T) {
+	t.Parallel()
+
+	doc, err := spec.Bundle("../processor/testdata/multi_file/api.yaml", spec.BundleOptions{
+		Dereference: false,
+		ExcludeTag:  "",
+	})
+	if err != nil {
+		t.Fatalf("failed to bundle: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := spec.Encode(&buf, doc, "yaml"); err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	b, err := os.ReadFile("testdata/multi_file.bundle.yaml")
+	if err != nil {
+		t.Fatalf("failed to read expected output file: %v", err)
+	}
+
+	assert.Equal(t, string(b), buf.String())
+}
+
+func TestBundleDereference(t *testing.T) {
+	t.Parallel()
+
+	doc, err := spec.Bundle("../processor/testdata/multi_file/api.yaml", spec.BundleOptions{
+		Dereference: true,
+		ExcludeTag:  "",
+	})
+	if err != nil {
+		t.Fatalf("failed to bundle: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := spec.Encode(&buf, doc, "json"); err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	assert.NotContains(t, buf.String(), "$ref")
+	assert.Contains(t, buf.String(), `"admin"`)
+
+	_, err = spec.Bundle("testdata/recursive.yaml", spec.BundleOptions{
+		Dereference: true,
+		ExcludeTag:  "",
+	})
+	if !errors.Is(err, spec.ErrCircularReference) {
+		t.Errorf("expected ErrCircularReference, got %v", err)
+	}
+}
+
+func TestBundleExcludeTag(t *testing.T) {
+	t.Parallel()
+
+	doc, err := spec.Bundle("testdata/recursive.yaml", spec.BundleOptions{
+		Dereference: false,
+		ExcludeTag:  "excludeme",
+	})
+	if err != nil {
+		t.Fatalf("failed to bundle: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := spec.Encode(&buf, doc, "yaml"); err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	assert.Contains(t, buf.String(), "getTree")
+	assert.NotContains(t, buf.String(), "/internal")
+	assert.Contains(t, buf.String(), `$ref: "#/components/schemas/Node"`)
+}
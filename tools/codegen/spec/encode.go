@@ -0,0 +1,125 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUnknownFormat = errors.New("unknown format")
+
+// Encode writes the document as YAML or JSON, keeping the order of the keys.
+func Encode(w io.Writer, doc *yaml.Node, format string) error {
+	switch format {
+	case "yaml":
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2) //nolint:mnd
+
+		if err := enc.Encode(doc); err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+
+		if err := enc.Close(); err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+
+		return nil
+	case "json":
+		var buf bytes.Buffer
+		if err := writeJSON(&buf, doc); err != nil {
+			return err
+		}
+
+		var out bytes.Buffer
+		if err := json.Indent(&out, buf.Bytes(), "", "  "); err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+
+		out.WriteByte('\n')
+
+		if _, err := out.WriteTo(w); err != nil {
+			return fmt.Errorf("failed to write JSON: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+func writeJSONValue(buf *bytes.Buffer, v any) error {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	// Encode adds a newline after the value
+	buf.Truncate(buf.Len() - 1)
+
+	return nil
+}
+
+// writeJSON writes the node as compact JSON. Mappings are written key by key as
+// decoding them into maps would lose their order.
+func writeJSON(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			buf.WriteString("null")
+			return nil
+		}
+
+		return writeJSON(buf, node.Content[0])
+	case yaml.AliasNode:
+		return writeJSON(buf, node.Alias)
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeJSONValue(buf, node.Content[i].Value); err != nil {
+				return err
+			}
+
+			buf.WriteByte(':')
+
+			if err := writeJSON(buf, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte('}')
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+
+		for i, child := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeJSON(buf, child); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+	case yaml.ScalarNode:
+		var v any
+		if err := node.Decode(&v); err != nil {
+			return fmt.Errorf("failed to decode %q at line %d: %w", node.Value, node.Line, err)
+		}
+
+		return writeJSONValue(buf, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/speakeasy-api/jsonpath/pkg/jsonpath"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUnsupportedOverlay = errors.New("unsupported overlay")
+
+// Overlay is an OpenAPI Overlay 1.0 document. Its actions modify a document
+// before it's processed, e.g. to hide internal endpoints or add extensions only
+// the SDKs need:
+//
+//	overlay: 1.0.0
+//	info:
+//	  title: SDK tweaks
+//	  version: 1.0.0
+//	actions:
+//	  - target: $.paths['/admin'].*
+//	    remove: true
+//	  - target: $.components.schemas.User
+//	    update:
+//	      x-ts-type: UserModel
+type Overlay struct {
+	Overlay string          `yaml:"overlay"`
+	Info    OverlayInfo     `yaml:"info"`
+	Extends string          `yaml:"extends"`
+	Actions []OverlayAction `yaml:"actions"`
+}
+
+type OverlayInfo struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+// OverlayAction updates or removes the nodes selected by the JSONPath expression
+// in Target.
+type OverlayAction struct {
+	Target      string    `yaml:"target"`
+	Description string    `yaml:"description"`
+	Update      yaml.Node `yaml:"update"`
+	Remove      bool      `yaml:"remove"`
+}
+
+// LoadOverlay reads an overlay file.
+func LoadOverlay(path string) (*Overlay, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overlay file: %w", err)
+	}
+
+	var overlay Overlay
+	if err := yaml.Unmarshal(b, &overlay); err != nil {
+		return nil, fmt.Errorf("failed to parse overlay file: %w", err)
+	}
+
+	if !strings.HasPrefix(overlay.Overlay, "1.") {
+		return nil, fmt.Errorf("%w: version %q, only 1.x is supported", ErrUnsupportedOverlay, overlay.Overlay)
+	}
+
+	return &overlay, nil
+}
+
+// Apply runs the actions of the overlay on the document in order. Actions whose
+// target selects no node have no effect.
+func (o *Overlay) Apply(doc *yaml.Node) error {
+	for i, action := range o.Actions {
+		path, err := jsonpath.NewPath(action.Target)
+		if err != nil {
+			return fmt.Errorf("invalid target of action %d %q: %w", i, action.Target, err)
+		}
+
+		targets := path.Query(doc)
+
+		if action.Remove {
+			parents := parentNodes(doc)
+			for _, target := range targets {
+				remove(parents[target], target)
+			}
+
+			continue
+		}
+
+		if action.Update.Kind == 0 {
+			continue
+		}
+
+		update := &action.Update
+		if update.Kind == yaml.DocumentNode && len(update.Content) > 0 {
+			update = update.Content[0]
+		}
+
+		for _, target := range targets {
+			merge(target, update)
+		}
+	}
+
+	return nil
+}
+
+// parentNodes returns the parent of every node under root.
+func parentNodes(root *yaml.Node) map[*yaml.Node]*yaml.Node {
+	parents := make(map[*yaml.Node]*yaml.Node)
+
+	var walk func(*yaml.Node)
+	walk = func(node *yaml.Node) {
+		for _, child := range node.Content {
+			parents[child] = node
+			walk(child)
+		}
+	}
+
+	walk(root)
+
+	return parents
+}
+
+// remove removes the node from its parent. Values of mappings are removed with
+// their key.
+func remove(parent, node *yaml.Node) {
+	if parent == nil {
+		return
+	}
+
+	for i, child := range parent.Content {
+		if child != node {
+			continue
+		}
+
+		switch parent.Kind { //nolint:exhaustive
+		case yaml.MappingNode:
+			if i%2 == 1 {
+				parent.Content = append(parent.Content[:i-1], parent.Content[i+1:]...)
+			}
+		case yaml.SequenceNode:
+			parent.Content = append(parent.Content[:i], parent.Content[i+1:]...)
+		}
+
+		return
+	}
+}
+
+// merge merges update into target as the Overlay specification describes:
+// properties of objects are merged recursively, values are appended to arrays
+// and anything else is replaced.
+func merge(target, update *yaml.Node) {
+	switch {
+	case target.Kind == yaml.MappingNode && update.Kind == yaml.MappingNode:
+		for i := 0; i+1 < len(update.Content); i += 2 {
+			key, value := update.Content[i], update.Content[i+1]
+
+			if existing := mappingValue(target, key.Value); existing != nil {
+				merge(existing, value)
+				continue
+			}
+
+			target.Content = append(target.Content, copyNode(key), copyNode(value))
+		}
+	case target.Kind == yaml.SequenceNode && update.Kind == yaml.SequenceNode:
+		for _, value := range update.Content {
+			target.Content = append(target.Content, copyNode(value))
+		}
+	case target.Kind == yaml.SequenceNode:
+		target.Content = append(target.Content, copyNode(update))
+	default:
+		*target = *copyNode(update)
+	}
+}
+
+// applyOverlay applies the overlay at path to the raw document.
+func applyOverlay(b []byte, path string) ([]byte, error) {
+	overlay, err := LoadOverlay(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI file: %w", err)
+	}
+
+	if err := overlay.Apply(&doc); err != nil {
+		return nil, err
+	}
+
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OpenAPI file: %w", err)
+	}
+
+	return out, nil
+}
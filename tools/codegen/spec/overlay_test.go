@@ -0,0 +1,100 @@
+package spec_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nhost/sdk-experiment/tools/codegen/spec"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestOverlayApply(t *testing.T) {
+	t.Parallel()
+
+	b, err := os.ReadFile("testdata/overlay/api.yaml")
+	if err != nil {
+		t.Fatalf("failed to read OpenAPI file: %v", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("failed to parse OpenAPI file: %v", err)
+	}
+
+	overlay, err := spec.LoadOverlay("testdata/overlay/overlay.yaml")
+	if err != nil {
+		t.Fatalf("failed to load overlay: %v", err)
+	}
+
+	if err := overlay.Apply(&doc); err != nil {
+		t.Fatalf("failed to apply overlay: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := spec.Encode(&buf, &doc, "yaml"); err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	expected, err := os.ReadFile("testdata/overlay/api.overlaid.yaml")
+	if err != nil {
+		t.Fatalf("failed to read expected output file: %v", err)
+	}
+
+	assert.Equal(t, string(expected), buf.String())
+}
+
+func TestLoadWithOverlay(t *testing.T) {
+	t.Parallel()
+
+	docModel, err := spec.LoadWithConfig("testdata/overlay/api.yaml", spec.Config{
+		BaseDir: "",
+		Overlay: "testdata/overlay/overlay.yaml",
+	})
+	if err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+
+	assert.Equal(t, 1, docModel.Model.Paths.PathItems.Len())
+
+	user := docModel.Model.Components.Schemas.GetOrZero("User").Schema()
+	createdAt := user.Properties.GetOrZero("createdAt").Schema()
+	assert.Equal(t, "date-time", createdAt.Format)
+	assert.Equal(t, "Date", createdAt.Extensions.GetOrZero("x-ts-type").Value)
+	assert.Equal(t, []string{"id", "createdAt"}, user.Required)
+}
+
+func TestLoadOverlayErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	unsupported := filepath.Join(dir, "unsupported.yaml")
+	if err := os.WriteFile(unsupported, []byte("overlay: 2.0.0\nactions: []\n"), 0o600); err != nil {
+		t.Fatalf("failed to write overlay: %v", err)
+	}
+
+	if _, err := spec.LoadOverlay(unsupported); !errors.Is(err, spec.ErrUnsupportedOverlay) {
+		t.Errorf("expected ErrUnsupportedOverlay, got %v", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(
+		invalid, []byte("overlay: 1.0.0\nactions:\n  - target: $.paths[\n    remove: true\n"), 0o600,
+	); err != nil {
+		t.Fatalf("failed to write overlay: %v", err)
+	}
+
+	overlay, err := spec.LoadOverlay(invalid)
+	if err != nil {
+		t.Fatalf("failed to load overlay: %v", err)
+	}
+
+	var doc yaml.Node
+	if err := overlay.Apply(&doc); err == nil {
+		t.Error("expected an error for an invalid target")
+	}
+}
@@ -0,0 +1,79 @@
+// Package spec loads the OpenAPI documents processed by the commands.
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// Config controls how a document and the files it references are loaded.
+type Config struct {
+	// BaseDir is the directory relative file references, e.g. ./common.yaml#/Error,
+	// are resolved from. Defaults to the directory of the document.
+	BaseDir string
+	// Overlay is the path of an OpenAPI Overlay applied to the document before
+	// it's processed. Only the document itself is modified, not the files it
+	// references.
+	Overlay string
+}
+
+// Load reads the OpenAPI document at path and builds its v3 model, resolving
+// references to other files relative to the directory of the document.
+func Load(path string) (*libopenapi.DocumentModel[v3.Document], error) {
+	return LoadWithConfig(path, Config{BaseDir: "", Overlay: ""})
+}
+
+// LoadWithConfig reads the OpenAPI document at path and builds its v3 model.
+func LoadWithConfig(path string, config Config) (*libopenapi.DocumentModel[v3.Document], error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenAPI file: %w", err)
+	}
+
+	if config.Overlay != "" {
+		if b, err = applyOverlay(b, config.Overlay); err != nil {
+			return nil, err
+		}
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path of OpenAPI file: %w", err)
+	}
+
+	baseDir := config.BaseDir
+	if baseDir == "" {
+		baseDir = filepath.Dir(abs)
+	}
+
+	if baseDir, err = filepath.Abs(baseDir); err != nil {
+		return nil, fmt.Errorf("failed to resolve base directory: %w", err)
+	}
+
+	rel, err := filepath.Rel(baseDir, abs)
+	if err != nil {
+		return nil, fmt.Errorf("OpenAPI file is not reachable from the base directory: %w", err)
+	}
+
+	document, err := libopenapi.NewDocumentWithConfiguration(b, &datamodel.DocumentConfiguration{ //nolint:exhaustruct
+		BasePath:            baseDir,
+		SpecFilePath:        rel,
+		AllowFileReferences: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+
+	docModel, errs := document.BuildV3Model()
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to build OpenAPI model: %w", errors.Join(errs...))
+	}
+
+	return docModel, nil
+}